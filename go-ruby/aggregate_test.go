@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestInject(t *testing.T) {
+	if res, ok := ruby.R(1, 5).Inject(func(a, b int) int { return a * b }); !ok || res != 24 {
+		t.Errorf("Expected 24, but got %d", res)
+	}
+	if _, ok := ruby.E([]int{}).Reduce(func(a, b int) int { return a + b }); ok {
+		t.Errorf("Expected no result for empty enumerable")
+	}
+	res := ruby.Inject(ruby.R(1, 4), "", func(acc string, el int) string {
+		return acc + strconv.Itoa(el)
+	})
+	if res != "123" {
+		t.Errorf("Expected 123, but got %s", res)
+	}
+	if res := ruby.Reduce(ruby.E([]int{}), 7, func(acc, el int) int { return acc + el }); res != 7 {
+		t.Errorf("Expected initial value 7, but got %d", res)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if res := ruby.Sum(ruby.RStepped(0, 10, 2)); res != 20 {
+		t.Errorf("Expected 20, but got %d", res)
+	}
+	if res := ruby.Sum(ruby.E([]float64{0.5, 1.5})); res != 2.0 {
+		t.Errorf("Expected 2.0, but got %f", res)
+	}
+	if res := ruby.Sum(ruby.E([]int{})); res != 0 {
+		t.Errorf("Expected 0, but got %d", res)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	e := ruby.E([]int{3, 1, 4, 1, 5})
+	if res, ok := ruby.Min(e); !ok || res != 1 {
+		t.Errorf("Expected 1, but got %d", res)
+	}
+	if res, ok := ruby.Max(e); !ok || res != 5 {
+		t.Errorf("Expected 5, but got %d", res)
+	}
+	if min, max, ok := ruby.MinMax(ruby.R(2, 7)); !ok || min != 2 || max != 6 {
+		t.Errorf("Expected 2 and 6, but got %d and %d", min, max)
+	}
+	if _, _, ok := ruby.MinMax(ruby.R(2, 2)); ok {
+		t.Errorf("Expected no result for empty range")
+	}
+	words := ruby.E([]string{"pear", "fig", "banana", "kiwi"})
+	if res, ok := ruby.MinBy(words, func(s string) int { return len(s) }); !ok || res != "fig" {
+		t.Errorf("Expected fig, but got %s", res)
+	}
+	if res, ok := ruby.MaxBy(words, func(s string) int { return len(s) }); !ok || res != "banana" {
+		t.Errorf("Expected banana, but got %s", res)
+	}
+	if res, ok := words.Max(strings.Compare); !ok || res != "pear" {
+		t.Errorf("Expected pear, but got %s", res)
+	}
+	if _, ok := ruby.MinBy(ruby.E([]string{}), func(s string) int { return len(s) }); ok {
+		t.Errorf("Expected no result for empty enumerable")
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestArrayStack(t *testing.T) {
+	a := ruby.NewArray(1, 2)
+	a.Push(3, 4).Unshift(0)
+	if !slices.Equal(a.ToSlice(), []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected 0..4, but got %v", a.ToSlice())
+	}
+	if v, ok := a.Pop(); !ok || v != 4 {
+		t.Errorf("Expected to pop 4, but got %d", v)
+	}
+	if v, ok := a.Shift(); !ok || v != 0 {
+		t.Errorf("Expected to shift 0, but got %d", v)
+	}
+	if a.Len() != 3 || a.Count() != 3 {
+		t.Errorf("Expected 3 values, but got %v", a.ToSlice())
+	}
+	if _, ok := ruby.NewArray[int]().Pop(); ok {
+		t.Errorf("Expected nothing to pop from an empty Array")
+	}
+}
+
+func TestArrayIndexing(t *testing.T) {
+	a := ruby.NewArray("a", "b", "c")
+	if v, ok := a.At(-1); !ok || v != "c" {
+		t.Errorf("Expected c, but got %s", v)
+	}
+	if _, ok := a.At(-4); ok {
+		t.Errorf("Expected -4 to be out of range")
+	}
+	if err := a.Set(4, "e"); err != nil || !slices.Equal(a.ToSlice(), []string{"a", "b", "c", "", "e"}) {
+		t.Errorf("Expected padding, but got %v", a.ToSlice())
+	}
+	if err := a.Set(-6, "x"); !errors.Is(err, ruby.ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange, but got %v", err)
+	}
+	if s, ok := a.Slice(-3, 2); !ok || !slices.Equal(s.ToSlice(), []string{"c", ""}) {
+		t.Errorf("Expected c and empty string, but got %v", s)
+	}
+	if v, ok := a.DeleteAt(-2); !ok || v != "" || a.Len() != 4 {
+		t.Errorf("Expected the empty string to be deleted, but got %v", a.ToSlice())
+	}
+}
+
+func TestArrayInsert(t *testing.T) {
+	a := ruby.NewArray(1, 2, 3)
+	a.Insert(1, 10, 11)
+	a.Insert(-2, 20)
+	if !slices.Equal(a.ToSlice(), []int{1, 10, 11, 2, 20, 3}) {
+		t.Errorf("Expected inserted values, but got %v", a.ToSlice())
+	}
+	if err := a.Insert(-8, 0); !errors.Is(err, ruby.ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange, but got %v", err)
+	}
+}
+
+func TestArrayDeleteIfAndFill(t *testing.T) {
+	a := ruby.NewArray(1, 2, 3, 4, 5).DeleteIf(func(x int) bool { return x%2 == 0 })
+	if !slices.Equal(a.ToSlice(), []int{1, 3, 5}) {
+		t.Errorf("Expected odd values, but got %v", a.ToSlice())
+	}
+	if res := a.Fill(0, 1).ToSlice(); !slices.Equal(res, []int{1, 0, 0}) {
+		t.Errorf("Expected 1, 0, 0, but got %v", res)
+	}
+	if res := a.Fill(7, 2, 3).ToSlice(); !slices.Equal(res, []int{1, 0, 7, 7, 7}) {
+		t.Errorf("Expected 1, 0, 7, 7, 7, but got %v", res)
+	}
+}
+
+func TestArrayCompactAndFlatten(t *testing.T) {
+	x := 1
+	ptrs := ruby.NewArray(&x, nil, &x).Compact()
+	if ptrs.Len() != 2 {
+		t.Errorf("Expected nil to be removed, but got %v", ptrs.ToSlice())
+	}
+	nested := ruby.NewArray[any](1, ruby.NewArray[any](2, []any{3, []any{4}}), 5)
+	if res := nested.Flatten().ToSlice(); !reflect.DeepEqual(res, []any{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected a flat Array, but got %v", res)
+	}
+	if res := nested.Flatten(1).Len(); res != 4 {
+		t.Errorf("Expected 4 values after flattening one level, but got %d", res)
+	}
+}
+
+func TestArrayRotateSampleShuffle(t *testing.T) {
+	a := ruby.NewArray(1, 2, 3, 4)
+	if res := a.Rotate().ToSlice(); !slices.Equal(res, []int{2, 3, 4, 1}) {
+		t.Errorf("Expected 2, 3, 4, 1, but got %v", res)
+	}
+	if res := a.Rotate(-1).ToSlice(); !slices.Equal(res, []int{4, 1, 2, 3}) {
+		t.Errorf("Expected 4, 1, 2, 3, but got %v", res)
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	if v, ok := a.Sample(rng); !ok || !slices.Contains(a.ToSlice(), v) {
+		t.Errorf("Expected a sample of the Array, but got %d", v)
+	}
+	shuffled := a.Shuffle(rand.New(rand.NewPCG(1, 2))).ToSlice()
+	again := a.Shuffle(rand.New(rand.NewPCG(1, 2))).ToSlice()
+	if !slices.Equal(shuffled, again) || !slices.Equal(slices.Sorted(slices.Values(shuffled)), a.ToSlice()) {
+		t.Errorf("Expected a reproducible permutation, but got %v and %v", shuffled, again)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	res, err := ruby.Transpose(ruby.EachSlice(ruby.R(1, 7), 3))
+	if err != nil || !reflect.DeepEqual(res.ToSlice(), [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Errorf("Expected transposed rows, but got %v, %v", res, err)
+	}
+	if _, err := ruby.Transpose(ruby.EachSlice(ruby.R(1, 6), 3)); !errors.Is(err, ruby.ErrElementSize) {
+		t.Errorf("Expected ErrElementSize, but got %v", err)
+	}
+}
+
+func TestArrayDig(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair[string, any]("list", ruby.NewArray[any]("a", "b")))
+	if v, ok := h.Dig("list", -1); !ok || v != "b" {
+		t.Errorf("Expected b, but got %v", v)
+	}
+}
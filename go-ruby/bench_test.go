@@ -0,0 +1,200 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+// Each benchmark pairs a go-ruby operation with its plain Go equivalent,
+// run with -benchmem to compare allocations.
+
+const benchSize = 10_000
+
+var (
+	benchData = ruby.R(0, benchSize).Entries()
+	benchSink int
+)
+
+func BenchmarkEachSlice(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.E(benchData)
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		e.Each(func(x int) {
+			sum += x
+		})
+		benchSink = sum
+	}
+}
+
+func BenchmarkEachArray(b *testing.B) {
+	b.ReportAllocs()
+	a := ruby.NewArray(benchData...)
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		a.Each(func(x int) {
+			sum += x
+		})
+		benchSink = sum
+	}
+}
+
+func BenchmarkEachSliceForLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, x := range benchData {
+			sum += x
+		}
+		benchSink = sum
+	}
+}
+
+func BenchmarkEachRange(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.R(0, benchSize)
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		e.Each(func(x int) {
+			sum += x
+		})
+		benchSink = sum
+	}
+}
+
+func BenchmarkEachRangeForLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for x := 0; x < benchSize; x++ {
+			sum += x
+		}
+		benchSink = sum
+	}
+}
+
+func BenchmarkCountSlice(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.E(benchData)
+	for i := 0; i < b.N; i++ {
+		benchSink = e.Count(func(x int) bool {
+			return x%3 == 0
+		})
+	}
+}
+
+func BenchmarkCountSliceForLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		n := 0
+		for _, x := range benchData {
+			if x%3 == 0 {
+				n++
+			}
+		}
+		benchSink = n
+	}
+}
+
+func BenchmarkAllSlice(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.E(benchData)
+	for i := 0; i < b.N; i++ {
+		if e.All(func(x int) bool { return x >= 0 }) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkAllSliceContainsFunc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !slices.ContainsFunc(benchData, func(x int) bool { return x < 0 }) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkSumRange(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.R(0, benchSize)
+	for i := 0; i < b.N; i++ {
+		benchSink = ruby.Sum(e)
+	}
+}
+
+func BenchmarkMapSlice(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.E(benchData)
+	for i := 0; i < b.N; i++ {
+		benchSink = len(e.Map(func(x int) int { return x * 2 }).Entries())
+	}
+}
+
+func BenchmarkMapSliceForLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		res := make([]int, 0, len(benchData))
+		for _, x := range benchData {
+			res = append(res, x*2)
+		}
+		benchSink = len(res)
+	}
+}
+
+func BenchmarkIndexSlice(b *testing.B) {
+	b.ReportAllocs()
+	e := ruby.E(benchData)
+	for i := 0; i < b.N; i++ {
+		benchSink = e.FindIndex(func(x int) bool { return x == benchSize/2 })
+	}
+}
+
+func BenchmarkIndexSliceIndexFunc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink = slices.IndexFunc(benchData, func(x int) bool { return x == benchSize/2 })
+	}
+}
+
+// Callbacks passed through the Enumerable interface escape, on the
+// concrete collection types the terminal operations do not allocate.
+func TestTerminalsDoNotAllocate(t *testing.T) {
+	a := ruby.NewArray(benchData...)
+	r := ruby.NewExclusiveRange(0, benchSize)
+	cases := map[string]func(){
+		"Array.Each": func() {
+			sum := 0
+			a.Each(func(x int) { sum += x })
+			benchSink = sum
+		},
+		"Range.Each": func() {
+			sum := 0
+			r.Each(func(x int) { sum += x })
+			benchSink = sum
+		},
+		"Array.Count": func() { benchSink = a.Count(func(x int) bool { return x%3 == 0 }) },
+		"Range.Count": func() { benchSink = r.Count() },
+		"Array.All":   func() { a.All(func(x int) bool { return x >= 0 }) },
+		"Range.Any":   func() { r.Any(func(x int) bool { return x < 0 }) },
+		"Array.FindIndex": func() {
+			benchSink = a.FindIndex(func(x int) bool { return x == benchSize/2 })
+		},
+		"Range.FindIndex": func() {
+			benchSink = r.FindIndex(func(x int) bool { return x == benchSize/2 })
+		},
+	}
+	for name, f := range cases {
+		if allocs := testing.AllocsPerRun(10, f); allocs != 0 {
+			t.Errorf("Expected %s not to allocate, but got %v allocs", name, allocs)
+		}
+	}
+	e := ruby.E(benchData)
+	if allocs := testing.AllocsPerRun(10, func() {
+		benchSink = e.FindIndex(func(x int) bool { return x == benchSize/2 })
+	}); allocs != 0 {
+		t.Errorf("Expected E.FindIndex with a static callback not to allocate, but got %v allocs", allocs)
+	}
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+type dataType struct {
+	Name     string
+	Receiver string
+	Kind     string
+	Mutable  bool
+	Fields   []field
+}
+
+type field struct {
+	Name     string
+	Accessor string
+	Type     string
+	Equal    string
+	// In and Out copy slices and maps passed in and handed out, keeping
+	// the values immutable.
+	In, Out string
+}
+
+type source struct {
+	file *ast.File
+	src  []byte
+}
+
+// generate returns the source declaring the methods of types, which are
+// looked up in the non test Go files of dir except output.
+func generate(dir string, types []string, mutable bool, output string) ([]byte, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var sources []source
+	var files []*ast.File
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") || filepath.Base(name) == output {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source{file, src})
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	// Errors are ignored, methods declared in output are missing while it
+	// is regenerated. Unresolved field types are compared by reflection.
+	info := &gotypes.Info{Types: map[ast.Expr]gotypes.TypeAndValue{}}
+	conf := gotypes.Config{Importer: importer.ForCompiler(fset, "source", nil), Error: func(error) {}}
+	pkg, _ := conf.Check(files[0].Name.Name, fset, files, info)
+	g := &generator{fset: fset, info: info, pkg: pkg, mutable: mutable}
+	found := map[string]dataType{}
+	for _, s := range sources {
+		for _, decl := range s.file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if !slices.Contains(types, ts.Name.Name) {
+					continue
+				}
+				t, used, err := g.parseType(ts, s.src)
+				if err != nil {
+					return nil, err
+				}
+				found[t.Name] = t
+				g.imports = append(g.imports, importsOf(s.file, used)...)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	var std, other []string
+	for _, imp := range g.imports {
+		if strings.Contains(strings.SplitN(imp, "/", 2)[0], ".") {
+			other = append(other, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(other)
+	data := map[string]any{"Package": files[0].Name.Name, "Std": slices.Compact(std), "Other": slices.Compact(other)}
+	if err := header.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	for _, name := range types {
+		t, ok := found[name]
+		if !ok {
+			return nil, fmt.Errorf("type %s not found in %s", name, dir)
+		}
+		if err := methods.Execute(&buf, t); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+type generator struct {
+	fset    *token.FileSet
+	info    *gotypes.Info
+	pkg     *gotypes.Package
+	mutable bool
+	imports []string
+}
+
+// parseType also returns the names of the packages the field types use.
+func (g *generator) parseType(ts *ast.TypeSpec, src []byte) (dataType, []string, error) {
+	t := dataType{Name: ts.Name.Name, Kind: "data", Mutable: g.mutable}
+	if g.mutable {
+		t.Kind = "struct"
+	}
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || ts.TypeParams != nil {
+		return t, nil, fmt.Errorf("%s must be a non generic struct type", t.Name)
+	}
+	text := func(n ast.Node) string {
+		return string(src[g.fset.Position(n.Pos()).Offset:g.fset.Position(n.End()).Offset])
+	}
+	var used []string
+	var equals, copies []string
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			return t, nil, fmt.Errorf("%s: embedded fields are not supported", t.Name)
+		}
+		ast.Inspect(f.Type, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used = append(used, id.Name)
+				}
+			}
+			return true
+		})
+		equal := g.equality(f.Type, text)
+		copying := g.copying(f.Type)
+		for _, n := range f.Names {
+			if n.IsExported() {
+				return t, nil, fmt.Errorf("%s: member %s must be unexported", t.Name, n.Name)
+			}
+			runes := []rune(n.Name)
+			runes[0] = unicode.ToUpper(runes[0])
+			t.Fields = append(t.Fields, field{Name: n.Name, Accessor: string(runes), Type: text(f.Type)})
+			equals = append(equals, equal)
+			copies = append(copies, copying)
+		}
+	}
+	t.Receiver = receiver(t)
+	for i, f := range t.Fields {
+		t.Fields[i].Equal = fmt.Sprintf(equals[i], t.Receiver+"."+f.Name, "other."+f.Name)
+		t.Fields[i].In = fmt.Sprintf(copies[i], f.Name)
+		t.Fields[i].Out = fmt.Sprintf(copies[i], t.Receiver+"."+f.Name)
+	}
+	return t, used, nil
+}
+
+// equality returns the format comparing two values of a field type. Types
+// with an Equal method, like time.Time, are compared by it, comparable
+// types by ==, slices and maps of those element by element. Interfaces,
+// whose dynamic values may not be comparable, and everything else are
+// compared by reflect.DeepEqual.
+func (g *generator) equality(expr ast.Expr, text func(ast.Node) string) string {
+	typ := g.info.TypeOf(expr)
+	switch {
+	case typ == nil || typ == gotypes.Typ[gotypes.Invalid]:
+	case g.hasEqual(typ):
+		return "%[1]s.Equal(%[2]s)"
+	case gotypes.IsInterface(typ):
+	case gotypes.Comparable(typ):
+		return "%[1]s == %[2]s"
+	default:
+		var elem gotypes.Type
+		var elemExpr ast.Expr
+		pkg := "slices"
+		switch u := typ.Underlying().(type) {
+		case *gotypes.Slice:
+			elem = u.Elem()
+			if a, ok := expr.(*ast.ArrayType); ok {
+				elemExpr = a.Elt
+			}
+		case *gotypes.Map:
+			elem, pkg = u.Elem(), "maps"
+			if m, ok := expr.(*ast.MapType); ok {
+				elemExpr = m.Value
+			}
+		}
+		switch {
+		case elem == nil:
+		case elemExpr != nil && g.hasEqual(elem):
+			g.imports = append(g.imports, strconv.Quote(pkg))
+			e := text(elemExpr)
+			return pkg + ".EqualFunc(%[1]s, %[2]s, func(x, y " + e + ") bool { return x.Equal(y) })"
+		case !gotypes.IsInterface(elem) && gotypes.Comparable(elem):
+			g.imports = append(g.imports, strconv.Quote(pkg))
+			return pkg + ".Equal(%[1]s, %[2]s)"
+		}
+	}
+	g.imports = append(g.imports, `"reflect"`)
+	return "reflect.DeepEqual(%[1]s, %[2]s)"
+}
+
+// copying returns the format copying a value of a field type, slices and
+// maps are cloned. The clone is shallow, values they refer to are shared.
+func (g *generator) copying(expr ast.Expr) string {
+	typ := g.info.TypeOf(expr)
+	if typ == nil {
+		return "%s"
+	}
+	switch typ.Underlying().(type) {
+	case *gotypes.Slice:
+		g.imports = append(g.imports, `"slices"`)
+		return "slices.Clone(%s)"
+	case *gotypes.Map:
+		g.imports = append(g.imports, `"maps"`)
+		return "maps.Clone(%s)"
+	}
+	return "%s"
+}
+
+// hasEqual reports whether typ has the method Equal(typ) bool.
+func (g *generator) hasEqual(typ gotypes.Type) bool {
+	obj, _, _ := gotypes.LookupFieldOrMethod(typ, true, g.pkg, "Equal")
+	fn, ok := obj.(*gotypes.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Type().(*gotypes.Signature)
+	return sig.Params().Len() == 1 && gotypes.Identical(sig.Params().At(0).Type(), typ) &&
+		sig.Results().Len() == 1 && gotypes.Identical(sig.Results().At(0).Type(), gotypes.Typ[gotypes.Bool])
+}
+
+// receiver avoids names of members, they are used as parameter names.
+func receiver(t dataType) string {
+	for _, r := range []string{strings.ToLower(t.Name[:1]), "recv", "recv_"} {
+		if !slices.ContainsFunc(t.Fields, func(f field) bool { return f.Name == r }) {
+			return r
+		}
+	}
+	return "recv__"
+}
+
+func importsOf(file *ast.File, used []string) []string {
+	var res []string
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if slices.Contains(used, name) {
+			res = append(res, strings.TrimSpace(fmt.Sprintf("%s %s", nameOf(spec), spec.Path.Value)))
+		}
+	}
+	return res
+}
+
+func nameOf(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	return ""
+}
+
+var header = template.Must(template.New("header").Parse(`// Code generated by rubydata; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{range .Std}}	{{.}}
+{{end}}{{if .Std}}
+{{end}}	"aschoerk.de/go-ruby/ruby"
+{{range .Other}}	{{.}}
+{{end}})
+`))
+
+var methods = template.Must(template.New("methods").Parse(`
+func New{{.Name}}({{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Name}} {{$f.Type}}{{end}}) {{.Name}} {
+	return {{.Name}}{ {{- range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Name}}: {{$f.In}}{{end -}} }
+}
+{{$t := .}}{{range .Fields}}
+func ({{$t.Receiver}} {{$t.Name}}) {{.Accessor}}() {{.Type}} {
+	return {{.Out}}
+}
+
+func ({{$t.Receiver}} {{$t.Name}}) With{{.Accessor}}({{.Name}} {{.Type}}) {{$t.Name}} {
+	{{$t.Receiver}}.{{.Name}} = {{.In}}
+	return {{$t.Receiver}}
+}
+{{if $t.Mutable}}
+func ({{$t.Receiver}} *{{$t.Name}}) Set{{.Accessor}}({{.Name}} {{.Type}}) {
+	{{$t.Receiver}}.{{.Name}} = {{.In}}
+}
+{{end}}{{end}}
+func ({{.Receiver}} {{.Name}}) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{ {{- range $i, $f := .Fields}}{{if $i}}, {{end}}"{{$f.Name}}"{{end -}} })
+}
+
+func ({{.Receiver}} {{.Name}}) Deconstruct() ({{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Type}}{{end}}) {
+{{- if .Fields}}
+	return {{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Out}}{{end}}
+{{- end}}
+}
+
+func ({{.Receiver}} {{.Name}}) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any](
+{{- range .Fields}}
+		ruby.NewPair[string, any]("{{.Name}}", {{.Out}}),
+{{- end}}
+	)
+}
+
+func ({{.Receiver}} {{.Name}}) Equal(other {{.Name}}) bool {
+	return {{if not .Fields}}true{{end}}{{range $i, $f := .Fields}}{{if $i}} &&
+		{{end}}{{$f.Equal}}{{end}}
+}
+
+func ({{.Receiver}} {{.Name}}) Inspect() string {
+	return "#<{{.Kind}} {{.Name}}
+{{- range $i, $f := .Fields}}{{if $i}},{{end}} {{$f.Name}}=" + ruby.Inspect({{$t.Receiver}}.{{$f.Name}}) + "{{end}}>"
+}
+
+func ({{.Receiver}} {{.Name}}) String() string {
+	return {{.Receiver}}.Inspect()
+}
+`))
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	cases := []struct {
+		types   []string
+		mutable bool
+		output  string
+	}{
+		{[]string{"Point", "Sample", "Timeline", "Origin"}, false, "point_data.go"},
+		{[]string{"Counter"}, true, "counter_data.go"},
+	}
+	for _, c := range cases {
+		got, err := generate("../../examples/shapes", c.types, c.mutable, c.output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile("../../examples/shapes/" + c.output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("Expected %s to be up to date, run go generate", c.output)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Exported struct{ X int }\n\ntype Embedded struct{ string }\n"
+	if err := os.WriteFile(dir+"/p.go", []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for typ, want := range map[string]string{
+		"Exported": "must be unexported",
+		"Embedded": "embedded fields",
+		"Missing":  "not found",
+	} {
+		_, err := generate(dir, []string{typ}, false, "out.go")
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing %q, but got %v", want, err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Rubydata generates Ruby Data.define and Struct.new style value types.
+//
+// The declaration is a struct type with unexported fields, the members:
+//
+//	//go:generate go run aschoerk.de/go-ruby/cmd/rubydata -type Point
+//	type Point struct {
+//		x, y int
+//	}
+//
+// For each type rubydata generates a constructor NewPoint(x, y), the
+// accessors X() and Y(), copy updates WithX and WithY, Members, Deconstruct,
+// ToH, Equal, Inspect and String. With -mutable it also generates setters
+// SetX and SetY as Ruby's Struct has them.
+//
+// Slice and map members are cloned when they are passed in and handed out,
+// so the caller can not change a value through them. The clones are
+// shallow, as Ruby's dup.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	log.SetPrefix("rubydata: ")
+	log.SetFlags(0)
+	typeNames := flag.String("type", "", "comma separated list of type names, must be set")
+	output := flag.String("output", "", "output file name, default <type>_data.go")
+	mutable := flag.Bool("mutable", false, "generate setters like Ruby's Struct")
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	types := strings.Split(*typeNames, ",")
+	if *output == "" {
+		*output = strings.ToLower(types[0]) + "_data.go"
+	}
+	src, err := generate(".", types, *mutable, filepath.Base(*output))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(fmt.Errorf("writing output: %w", err))
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestZip(t *testing.T) {
+	res := ruby.Zip(ruby.R(1, 4), ruby.E([]int{4, 5, 6}), ruby.E([]int{7})).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 4, 7}, {2, 5, 0}, {3, 6, 0}}) {
+		t.Errorf("Expected zipped values, but got %v", res)
+	}
+	pairs := ruby.Zip2(ruby.E([]string{"a", "b"}), ruby.R(0, math.MaxInt).Lazy()).Entries()
+	if !reflect.DeepEqual(pairs, []ruby.Pair[string, int]{{Key: "a", Value: 0}, {Key: "b", Value: 1}}) {
+		t.Errorf("Expected pairs, but got %v", pairs)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	res := ruby.Product(ruby.R(1, 3), ruby.R(3, 5)).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}) {
+		t.Errorf("Expected product, but got %v", res)
+	}
+	if res := ruby.Product(ruby.R(1, 3), ruby.R(0, 0)).Count(); res != 0 {
+		t.Errorf("Expected empty product, but got %d", res)
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	res := ruby.Permutation(ruby.R(1, 4), 2).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}) {
+		t.Errorf("Expected permutations, but got %v", res)
+	}
+	if res := ruby.Permutation(ruby.R(0, 5), 5).Count(); res != 120 {
+		t.Errorf("Expected 120 permutations, but got %d", res)
+	}
+	if res := ruby.Permutation(ruby.R(1, 3), 0).Entries(); !reflect.DeepEqual(res, [][]int{{}}) {
+		t.Errorf("Expected one empty permutation, but got %v", res)
+	}
+	if res := ruby.Permutation(ruby.R(1, 3), 3).Count(); res != 0 {
+		t.Errorf("Expected no permutations, but got %d", res)
+	}
+}
+
+func TestCombination(t *testing.T) {
+	res := ruby.Combination(ruby.R(1, 5), 2).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}) {
+		t.Errorf("Expected combinations, but got %v", res)
+	}
+	if res := ruby.Combination(ruby.R(1, 5), -1).Count(); res != 0 {
+		t.Errorf("Expected no combinations, but got %d", res)
+	}
+}
+
+func TestRepeatedSelections(t *testing.T) {
+	res := ruby.RepeatedCombination(ruby.R(1, 4), 2).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}) {
+		t.Errorf("Expected repeated combinations, but got %v", res)
+	}
+	res = ruby.RepeatedPermutation(ruby.R(0, 2), 2).Entries()
+	if !reflect.DeepEqual(res, [][]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}}) {
+		t.Errorf("Expected repeated permutations, but got %v", res)
+	}
+}
+
+func TestLazyCombinatorics(t *testing.T) {
+	res := ruby.RepeatedPermutation(ruby.R(0, 10).Lazy(), 10).First(2)
+	if !reflect.DeepEqual(res, [][]int{{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, {0, 0, 0, 0, 0, 0, 0, 0, 0, 1}}) {
+		t.Errorf("Expected first two selections, but got %v", res)
+	}
+	if !ruby.Combination(ruby.E([]int{1, 2}), 1).IsLazy() || !ruby.Product(ruby.E([]int{1})).IsLazy() {
+		t.Fatalf("Expected selections and products of eager sources to be lazy")
+	}
+	// 20! permutations could not be built up front.
+	first := ruby.Permutation(ruby.R(0, 20), 20).First(1)
+	if !reflect.DeepEqual(first, [][]int{ruby.R(0, 20).Entries()}) {
+		t.Errorf("Expected the first permutation, but got %v", first)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(o version) int {
+	if v.major != o.major {
+		return (v.major - o.major) * 100
+	}
+	return v.minor - o.minor
+}
+
+func TestInclude(t *testing.T) {
+	if !ruby.Include(ruby.E([]string{"a", "b"}), "b") || ruby.Include(ruby.E([]string{"a"}), "c") {
+		t.Errorf("Expected b to be included and c not")
+	}
+	if !ruby.Include[int](ruby.NewSet(1, 2), 2) || !ruby.Include[int](ruby.NewRange(1, 1_000_000_000_000), 999) {
+		t.Errorf("Expected Set and Range membership")
+	}
+	sameDay := func(a, b time.Time) bool { return a.YearDay() == b.YearDay() }
+	days := ruby.E([]time.Time{time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)})
+	if !days.IncludeFunc(time.Date(2024, 3, 1, 17, 0, 0, 0, time.UTC), sameDay) {
+		t.Errorf("Expected the day to be included")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	if ruby.Compare(1, 2) != -1 || ruby.Compare("b", "a") != 1 || ruby.Compare(1.5, 1.5) != 0 {
+		t.Errorf("Expected -1, 1 and 0")
+	}
+	c := ruby.ComparableComparator[version]()
+	if c(version{1, 2}, version{2, 0}) != -1 || c(version{1, 2}, version{1, 0}) != 1 {
+		t.Errorf("Expected the comparison to be normalized to -1 and 1")
+	}
+}
+
+func TestBetweenAndClamp(t *testing.T) {
+	if !ruby.Between(5, 1, 5) || ruby.Between(6, 1, 5) {
+		t.Errorf("Expected 5 to be between 1 and 5, but not 6")
+	}
+	if ruby.Clamp(12, 0, 10) != 10 || ruby.Clamp(-1, 0, 10) != 0 || ruby.Clamp(3, 0, 10) != 3 {
+		t.Errorf("Expected values to be clamped")
+	}
+	c := ruby.ComparableComparator[version]()
+	if !c.Between(version{1, 5}, version{1, 0}, version{2, 0}) {
+		t.Errorf("Expected 1.5 to be between 1.0 and 2.0")
+	}
+	if res := c.Clamp(version{3, 1}, version{1, 0}, version{2, 0}); res != (version{2, 0}) {
+		t.Errorf("Expected 2.0, but got %v", res)
+	}
+	sorted := ruby.E([]version{{2, 0}, {1, 9}, {1, 10}}).Sort(c.Reverse()).Entries()
+	if !slices.Equal(sorted, []version{{2, 0}, {1, 10}, {1, 9}}) {
+		t.Errorf("Expected descending versions, but got %v", sorted)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestFromChanAndToChan(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		for i := range 5 {
+			ch <- i
+		}
+		close(ch)
+	}()
+	if res := ruby.FromChan(ch).Select(func(a int) bool { return a%2 == 0 }).Entries(); !slices.Equal(res, []int{0, 2, 4}) {
+		t.Errorf("Expected 0, 2, 4, but got %v", res)
+	}
+	res := []int{}
+	for v := range ruby.R(0, 3).ToChan(context.Background()) {
+		res = append(res, v)
+	}
+	if !slices.Equal(res, []int{0, 1, 2}) {
+		t.Errorf("Expected 0, 1, 2, but got %v", res)
+	}
+}
+
+func TestToChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ruby.RFrom(0).ToChan(ctx)
+	<-ch
+	cancel()
+	for range ch {
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	slow := func(a int) int {
+		time.Sleep(time.Duration(10-a) * time.Millisecond)
+		return a * a
+	}
+	if res := ruby.ParallelMap(ruby.R(0, 10), 4, slow).Entries(); !slices.Equal(res, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}) {
+		t.Errorf("Expected ordered squares, but got %v", res)
+	}
+	res := ruby.ParallelMapUnordered(ruby.R(0, 10), 4, slow).Entries()
+	if !slices.Equal(slices.Sorted(slices.Values(res)), []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}) {
+		t.Errorf("Expected all squares, but got %v", res)
+	}
+	var sum atomic.Int64
+	ruby.R(1, 101).ParallelEach(8, func(a int) {
+		sum.Add(int64(a))
+	})
+	if sum.Load() != 5050 {
+		t.Errorf("Expected 5050, but got %d", sum.Load())
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := ruby.RFrom(0).WithContext(ctx)
+	seen := 0
+	err := s.EachContext(ctx, func(a int) {
+		seen++
+		if seen == 3 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) || seen != 3 {
+		t.Errorf("Expected cancellation after 3 values, but got %v after %d", err, seen)
+	}
+	if s.Count() != 0 || !errors.Is(s.Err(), context.Canceled) {
+		t.Errorf("Expected the stream to report the cancellation, but got %v", s.Err())
+	}
+	live := ruby.R(0, 3).WithContext(context.Background())
+	if live.Count() != 3 || live.Err() != nil {
+		t.Errorf("Expected 3 values without error, but got %v", live.Err())
+	}
+}
+
+func TestWithContextSilentSources(t *testing.T) {
+	silent := make(chan int)
+	defer close(silent)
+	sources := map[string]ruby.Enumerable[int]{
+		"FromChan":      ruby.FromChan(silent),
+		"Map(FromChan)": ruby.FromChan(silent).Lazy().Map(func(a int) int { return a }),
+		"FromSeq": ruby.FromSeq(func(yield func(int) bool) {
+			for v := range silent {
+				if !yield(v) {
+					return
+				}
+			}
+		}),
+	}
+	for name, e := range sources {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		err := e.EachContext(ctx, func(int) {})
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected %s to give up waiting, but got %v", name, err)
+		}
+	}
+}
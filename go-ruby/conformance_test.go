@@ -0,0 +1,118 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+// The cases mirror the examples of Ruby's Enumerable documentation and
+// spec, the Ruby expression is given as name.
+
+type flag bool
+
+func length(s string) int {
+	return len(s)
+}
+
+func TestRubyPredicates(t *testing.T) {
+	var nilPtr *int
+	words := ruby.E([]string{"ant", "bear", "cat"})
+	empty := ruby.E([]any{})
+	cases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"[].all?", empty.All(), true},
+		{"[].any?", empty.Any(), false},
+		{"[].none?", empty.None(), true},
+		{"[].one?", empty.One(), false},
+		{"[nil, true, 99].all?", ruby.E([]any{nil, true, 99}).All(), false},
+		{"[0, ''].all?", ruby.E([]any{0, ""}).All(), true},
+		{"[nil, false].any?", ruby.E([]any{nil, false}).Any(), false},
+		{"[nil, false, 0].any?", ruby.E([]any{nil, false, 0}).Any(), true},
+		{"[nil, false].none?", ruby.E([]any{nil, false}).None(), true},
+		{"[nil, ''].none?", ruby.E([]any{nil, ""}).None(), false},
+		{"[nil, true].one?", ruby.E([]any{nil, true}).One(), true},
+		{"[nil, true, 1].one?", ruby.E([]any{nil, true, 1}).One(), false},
+		{"[nil_ptr].any?", ruby.E([]*int{nilPtr}).Any(), false},
+		{"[flag(false)].any?", ruby.E([]flag{false}).Any(), false},
+		{"[flag(true)].all?", ruby.E([]flag{true}).All(), true},
+		{"%w[ant bear cat].all? { |w| w.length >= 3 }", words.All(func(w string) bool { return len(w) >= 3 }), true},
+		{"%w[ant bear cat].all? { |w| w.length >= 4 }", words.All(func(w string) bool { return len(w) >= 4 }), false},
+		{"%w[ant bear cat].any? { |w| w.length >= 4 }", words.Any(func(w string) bool { return len(w) >= 4 }), true},
+		{"%w[ant bear cat].any? { |w| w.length >= 5 }", words.Any(func(w string) bool { return len(w) >= 5 }), false},
+		{"%w[ant bear cat].none? { |w| w.length == 5 }", words.None(func(w string) bool { return len(w) == 5 }), true},
+		{"%w[ant bear cat].none? { |w| w.length >= 4 }", words.None(func(w string) bool { return len(w) >= 4 }), false},
+		{"%w[ant bear cat].one? { |w| w.length == 4 }", words.One(func(w string) bool { return len(w) == 4 }), true},
+		{"%w[ant bear cat].one? { |w| w.length == 3 }", words.One(func(w string) bool { return len(w) == 3 }), false},
+		{"[3, 4].any? { |x| x > 2 }", ruby.E([]int{3, 4}).Any(func(x int) bool { return x > 2 }), true},
+		{"[1, 2, 3].any? { |x| x > 5 }", ruby.E([]int{1, 2, 3}).Any(func(x int) bool { return x > 5 }), false},
+		{"(1..3).include?(3)", ruby.Include[int](ruby.NewRange(1, 3), 3), true},
+		{"(1...3).include?(3)", ruby.Include[int](ruby.NewExclusiveRange(1, 3), 3), false},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s: expected %v, but got %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestRubyEnumerable(t *testing.T) {
+	animals := ruby.E([]string{"albatross", "dog", "horse"})
+	inject := func(e ruby.Enumerable[int]) any {
+		res, ok := e.Inject(func(a, b int) int { return a + b })
+		return []any{res, ok}
+	}
+	minmax := func() any {
+		min, max, _ := ruby.MinMax(animals)
+		return []string{min, max}
+	}
+	first := func(v string, _ bool) string {
+		return v
+	}
+	cases := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"(1..4).inject(:+)", inject(ruby.NewRange(1, 4)), []any{10, true}},
+		{"[].inject(:+)", inject(ruby.E([]int{})), []any{0, false}},
+		{"(1..3).sum", ruby.Sum[int](ruby.NewRange(1, 3)), 6},
+		{"[].sum", ruby.Sum(ruby.E([]float64{})), 0.0},
+		{"%w[albatross dog horse].min", first(ruby.Min(animals)), "albatross"},
+		{"%w[albatross dog horse].max_by(&:length)", first(ruby.MaxBy(animals, length)), "albatross"},
+		{"%w[albatross dog horse].min_by(&:length)", first(ruby.MinBy(animals, length)), "dog"},
+		{"%w[albatross dog horse].minmax", minmax(), []string{"albatross", "horse"}},
+		{"(1..10).each_slice(3).to_a", ruby.EachSlice[int](ruby.NewRange(1, 10), 3).Entries(), [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}},
+		{"(1..5).each_cons(3).to_a", ruby.EachCons[int](ruby.NewRange(1, 5), 3).Entries(), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"[1, 2, 4, 9, 10, 11, 12, 15, 16, 19, 20, 21].chunk_while { |i, j| i + 1 == j }",
+			ruby.ChunkWhile(ruby.E([]int{1, 2, 4, 9, 10, 11, 12, 15, 16, 19, 20, 21}), func(i, j int) bool { return i+1 == j }).Entries(),
+			[][]int{{1, 2}, {4}, {9, 10, 11, 12}, {15, 16}, {19, 20, 21}}},
+		{"%w[a b c b a].tally", ruby.Tally(ruby.E([]string{"a", "b", "c", "b", "a"})), map[string]int{"a": 2, "b": 2, "c": 1}},
+		{"(1..6).group_by { |i| i % 3 }", ruby.GroupBy[int](ruby.NewRange(1, 6), func(i int) int { return i % 3 }), map[int][]int{0: {3, 6}, 1: {1, 4}, 2: {2, 5}}},
+		{"[3, 1, 2].sort", ruby.Sort(ruby.E([]int{3, 1, 2})).Entries(), []int{1, 2, 3}},
+		{"%w[apple pear fig].sort_by(&:length)", ruby.SortBy(ruby.E([]string{"apple", "pear", "fig"}), length).Entries(), []string{"fig", "pear", "apple"}},
+		{"[1, 2, 2, 3].uniq", ruby.Uniq(ruby.E([]int{1, 2, 2, 3})).Entries(), []int{1, 2, 3}},
+		{"[1, 2, 3].reverse_each.to_a", ruby.E([]int{1, 2, 3}).Reverse().Entries(), []int{3, 2, 1}},
+		{"[1, 2, 3, 4].take_while { |i| i < 3 }", ruby.R(1, 5).TakeWhile(func(i int) bool { return i < 3 }).Entries(), []int{1, 2}},
+		{"[1, 2, 3, 4].drop_while { |i| i < 3 }", ruby.R(1, 5).DropWhile(func(i int) bool { return i < 3 }).Entries(), []int{3, 4}},
+		{"[1, 2, 3, 4].drop(3)", ruby.R(1, 5).Drop(3).Entries(), []int{4}},
+		{"(1..10).find_index(5)", ruby.NewRange(1, 10).FindIndex(func(i int) bool { return i == 5 }), 4},
+		{"(1..3).flat_map { |x| [x, -x] }", ruby.NewRange(1, 3).FlatMap(func(x int) ruby.Enumerable[int] { return ruby.E([]int{x, -x}) }).Entries(), []int{1, -1, 2, -2, 3, -3}},
+		{"[1, 2, 3].zip([4, 5, 6], [7, 8])", ruby.Zip(ruby.R(1, 4), ruby.E([]int{4, 5, 6}), ruby.E([]int{7, 8})).Entries(), [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 0}}},
+		{"[1, 2, 3].combination(2).to_a", ruby.Combination(ruby.R(1, 4), 2).Entries(), [][]int{{1, 2}, {1, 3}, {2, 3}}},
+		{"[1, 2, 3].permutation(2).count", ruby.Permutation(ruby.R(1, 4), 2).Count(), 6},
+		{"(1..3).cycle.first(5)", ruby.NewRange(1, 3).Cycle().First(5), []int{1, 2, 3, 1, 2}},
+		{"%w[a b].each_with_index.to_a", ruby.Zip2(ruby.E([]string{"a", "b"}), ruby.RFrom(0)).Entries(), []ruby.Pair[string, int]{{Key: "a", Value: 0}, {Key: "b", Value: 1}}},
+		{"'hello world'.split.map(&:upcase)", ruby.Words("hello world").Map(strings.ToUpper).Entries(), []string{"HELLO", "WORLD"}},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s: expected %v, but got %v", c.name, c.want, c.got)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"aschoerk.de/go-ruby/examples/shapes"
+)
+
+func TestData(t *testing.T) {
+	p := shapes.NewPoint(1, 2)
+	q := p.WithY(5)
+	if p.Y() != 2 || q.X() != 1 || q.Y() != 5 {
+		t.Errorf("Expected With to copy, but got %v and %v", p, q)
+	}
+	if x, y := q.Deconstruct(); x != 1 || y != 5 {
+		t.Errorf("Expected 1, 5, but got %d, %d", x, y)
+	}
+	if !p.Equal(shapes.NewPoint(1, 2)) || p.Equal(q) {
+		t.Errorf("Expected structural equality")
+	}
+	if got := p.Members().Entries(); !slices.Equal(got, []string{"x", "y"}) {
+		t.Errorf("Expected [x y], but got %v", got)
+	}
+	if got := p.ToH().Inspect(); got != `{"x" => 1, "y" => 2}` {
+		t.Errorf("Expected hash, but got %s", got)
+	}
+	if got := p.String(); got != "#<data Point x=1, y=2>" {
+		t.Errorf("Expected inspect string, but got %s", got)
+	}
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := shapes.NewSample("a", at, []string{"t"})
+	if !s.Equal(shapes.NewSample("a", at, []string{"t"})) {
+		t.Errorf("Expected samples with equal slices to be equal")
+	}
+	if !s.Equal(shapes.NewSample("a", at.In(time.Local), []string{"t"})) {
+		t.Errorf("Expected samples of the same instant in different locations to be equal")
+	}
+	now := time.Now()
+	if !shapes.NewSample("a", now, nil).Equal(shapes.NewSample("a", now.Round(0), []string{})) {
+		t.Errorf("Expected samples with and without monotonic reading to be equal")
+	}
+	tl := shapes.NewTimeline([]time.Time{at}, map[string]any{"k": []int{1}})
+	if !tl.Equal(shapes.NewTimeline([]time.Time{at.In(time.Local)}, map[string]any{"k": []int{1}})) {
+		t.Errorf("Expected timelines to be compared element by element")
+	}
+	if tl.Equal(tl.WithMeta(nil)) {
+		t.Errorf("Expected timelines with different meta not to be equal")
+	}
+	tags := []string{"t"}
+	shared := shapes.NewSample("a", at, tags)
+	with := shared.WithTags(tags)
+	tags[0] = "changed"
+	shared.Tags()[0] = "changed"
+	if shared.Tags()[0] != "t" || with.Tags()[0] != "t" || !shared.Equal(s) {
+		t.Errorf("Expected slice members to be copied, but got %v and %v", shared, with)
+	}
+	meta := map[string]any{"k": 1}
+	tl = shapes.NewTimeline(nil, meta)
+	meta["k"] = 2
+	_, m := tl.Deconstruct()
+	m["k"] = 3
+	if tl.Meta()["k"] != 1 {
+		t.Errorf("Expected map members to be copied, but got %v", tl)
+	}
+	o := shapes.NewOrigin()
+	if !o.Equal(shapes.Origin{}) || o.Members().Count() != 0 || o.ToH().Len() != 0 || o.Inspect() != "#<data Origin>" {
+		t.Errorf("Expected a member-less type, but got %v", o)
+	}
+	c := shapes.NewCounter("c", 1)
+	c.SetCount(c.Count() + 1)
+	if got := c.Inspect(); got != `#<struct Counter name="c", count=2>` {
+		t.Errorf("Expected struct inspect, but got %s", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by rubydata; DO NOT EDIT.
+
+package shapes
+
+import (
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func NewCounter(name string, count int) Counter {
+	return Counter{name: name, count: count}
+}
+
+func (c Counter) Name() string {
+	return c.name
+}
+
+func (c Counter) WithName(name string) Counter {
+	c.name = name
+	return c
+}
+
+func (c *Counter) SetName(name string) {
+	c.name = name
+}
+
+func (c Counter) Count() int {
+	return c.count
+}
+
+func (c Counter) WithCount(count int) Counter {
+	c.count = count
+	return c
+}
+
+func (c *Counter) SetCount(count int) {
+	c.count = count
+}
+
+func (c Counter) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{"name", "count"})
+}
+
+func (c Counter) Deconstruct() (string, int) {
+	return c.name, c.count
+}
+
+func (c Counter) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any](
+		ruby.NewPair[string, any]("name", c.name),
+		ruby.NewPair[string, any]("count", c.count),
+	)
+}
+
+func (c Counter) Equal(other Counter) bool {
+	return c.name == other.name &&
+		c.count == other.count
+}
+
+func (c Counter) Inspect() string {
+	return "#<struct Counter name=" + ruby.Inspect(c.name) + ", count=" + ruby.Inspect(c.count) + ">"
+}
+
+func (c Counter) String() string {
+	return c.Inspect()
+}
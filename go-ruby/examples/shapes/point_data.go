@@ -0,0 +1,200 @@
+// Code generated by rubydata; DO NOT EDIT.
+
+package shapes
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func NewPoint(x int, y int) Point {
+	return Point{x: x, y: y}
+}
+
+func (p Point) X() int {
+	return p.x
+}
+
+func (p Point) WithX(x int) Point {
+	p.x = x
+	return p
+}
+
+func (p Point) Y() int {
+	return p.y
+}
+
+func (p Point) WithY(y int) Point {
+	p.y = y
+	return p
+}
+
+func (p Point) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{"x", "y"})
+}
+
+func (p Point) Deconstruct() (int, int) {
+	return p.x, p.y
+}
+
+func (p Point) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any](
+		ruby.NewPair[string, any]("x", p.x),
+		ruby.NewPair[string, any]("y", p.y),
+	)
+}
+
+func (p Point) Equal(other Point) bool {
+	return p.x == other.x &&
+		p.y == other.y
+}
+
+func (p Point) Inspect() string {
+	return "#<data Point x=" + ruby.Inspect(p.x) + ", y=" + ruby.Inspect(p.y) + ">"
+}
+
+func (p Point) String() string {
+	return p.Inspect()
+}
+
+func NewSample(label string, at time.Time, tags []string) Sample {
+	return Sample{label: label, at: at, tags: slices.Clone(tags)}
+}
+
+func (s Sample) Label() string {
+	return s.label
+}
+
+func (s Sample) WithLabel(label string) Sample {
+	s.label = label
+	return s
+}
+
+func (s Sample) At() time.Time {
+	return s.at
+}
+
+func (s Sample) WithAt(at time.Time) Sample {
+	s.at = at
+	return s
+}
+
+func (s Sample) Tags() []string {
+	return slices.Clone(s.tags)
+}
+
+func (s Sample) WithTags(tags []string) Sample {
+	s.tags = slices.Clone(tags)
+	return s
+}
+
+func (s Sample) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{"label", "at", "tags"})
+}
+
+func (s Sample) Deconstruct() (string, time.Time, []string) {
+	return s.label, s.at, slices.Clone(s.tags)
+}
+
+func (s Sample) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any](
+		ruby.NewPair[string, any]("label", s.label),
+		ruby.NewPair[string, any]("at", s.at),
+		ruby.NewPair[string, any]("tags", slices.Clone(s.tags)),
+	)
+}
+
+func (s Sample) Equal(other Sample) bool {
+	return s.label == other.label &&
+		s.at.Equal(other.at) &&
+		slices.Equal(s.tags, other.tags)
+}
+
+func (s Sample) Inspect() string {
+	return "#<data Sample label=" + ruby.Inspect(s.label) + ", at=" + ruby.Inspect(s.at) + ", tags=" + ruby.Inspect(s.tags) + ">"
+}
+
+func (s Sample) String() string {
+	return s.Inspect()
+}
+
+func NewTimeline(stamps []time.Time, meta map[string]any) Timeline {
+	return Timeline{stamps: slices.Clone(stamps), meta: maps.Clone(meta)}
+}
+
+func (t Timeline) Stamps() []time.Time {
+	return slices.Clone(t.stamps)
+}
+
+func (t Timeline) WithStamps(stamps []time.Time) Timeline {
+	t.stamps = slices.Clone(stamps)
+	return t
+}
+
+func (t Timeline) Meta() map[string]any {
+	return maps.Clone(t.meta)
+}
+
+func (t Timeline) WithMeta(meta map[string]any) Timeline {
+	t.meta = maps.Clone(meta)
+	return t
+}
+
+func (t Timeline) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{"stamps", "meta"})
+}
+
+func (t Timeline) Deconstruct() ([]time.Time, map[string]any) {
+	return slices.Clone(t.stamps), maps.Clone(t.meta)
+}
+
+func (t Timeline) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any](
+		ruby.NewPair[string, any]("stamps", slices.Clone(t.stamps)),
+		ruby.NewPair[string, any]("meta", maps.Clone(t.meta)),
+	)
+}
+
+func (t Timeline) Equal(other Timeline) bool {
+	return slices.EqualFunc(t.stamps, other.stamps, func(x, y time.Time) bool { return x.Equal(y) }) &&
+		reflect.DeepEqual(t.meta, other.meta)
+}
+
+func (t Timeline) Inspect() string {
+	return "#<data Timeline stamps=" + ruby.Inspect(t.stamps) + ", meta=" + ruby.Inspect(t.meta) + ">"
+}
+
+func (t Timeline) String() string {
+	return t.Inspect()
+}
+
+func NewOrigin() Origin {
+	return Origin{}
+}
+
+func (o Origin) Members() ruby.Enumerable[string] {
+	return ruby.E([]string{})
+}
+
+func (o Origin) Deconstruct() {
+}
+
+func (o Origin) ToH() *ruby.Hash[string, any] {
+	return ruby.NewHash[string, any]()
+}
+
+func (o Origin) Equal(other Origin) bool {
+	return true
+}
+
+func (o Origin) Inspect() string {
+	return "#<data Origin>"
+}
+
+func (o Origin) String() string {
+	return o.Inspect()
+}
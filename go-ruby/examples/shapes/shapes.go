@@ -0,0 +1,29 @@
+// Package shapes declares value types generated by rubydata.
+package shapes
+
+import "time"
+
+//go:generate go run aschoerk.de/go-ruby/cmd/rubydata -type Point,Sample,Timeline,Origin
+type Point struct {
+	x, y int
+}
+
+type Sample struct {
+	label string
+	at    time.Time
+	tags  []string
+}
+
+type Timeline struct {
+	stamps []time.Time
+	meta   map[string]any
+}
+
+// Origin has no members, as Data.define without arguments.
+type Origin struct{}
+
+//go:generate go run aschoerk.de/go-ruby/cmd/rubydata -type Counter -mutable
+type Counter struct {
+	name  string
+	count int
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestExternalEnumerator(t *testing.T) {
+	e := ruby.R(1, 3).ToEnum()
+	if v, err := e.Peek(); err != nil || v != 1 {
+		t.Errorf("Expected to peek 1, but got %d, %v", v, err)
+	}
+	if v, err := e.Next(); err != nil || v != 1 {
+		t.Errorf("Expected 1, but got %d, %v", v, err)
+	}
+	if v, err := e.Next(); err != nil || v != 2 {
+		t.Errorf("Expected 2, but got %d, %v", v, err)
+	}
+	if _, err := e.Next(); !errors.Is(err, ruby.ErrStopIteration) {
+		t.Errorf("Expected ErrStopIteration, but got %v", err)
+	}
+	if _, err := e.Peek(); !errors.Is(err, ruby.ErrStopIteration) {
+		t.Errorf("Expected ErrStopIteration, but got %v", err)
+	}
+	e.Rewind()
+	if v, err := e.Next(); err != nil || v != 1 {
+		t.Errorf("Expected 1 after Rewind, but got %d, %v", v, err)
+	}
+}
+
+func TestExternalEnumeratorMerge(t *testing.T) {
+	a := ruby.E([]int{1, 4, 9}).ToEnum()
+	b := ruby.E([]int{2, 3, 10, 11}).ToEnum()
+	res := []int{}
+	for {
+		x, errA := a.Peek()
+		y, errB := b.Peek()
+		if errA != nil && errB != nil {
+			break
+		}
+		if errB != nil || (errA == nil && x <= y) {
+			a.Next()
+			res = append(res, x)
+		} else {
+			b.Next()
+			res = append(res, y)
+		}
+	}
+	if !slices.Equal(res, []int{1, 2, 3, 4, 9, 10, 11}) {
+		t.Errorf("Expected merged sequence, but got %v", res)
+	}
+}
+
+func TestExternalEnumeratorCloses(t *testing.T) {
+	closed := 0
+	e := ruby.FromGenerator(func() ruby.Enumerator[string] {
+		return &cursor{[]string{"a"}, 0, &closed}
+	}).ToEnum()
+	for {
+		if _, err := e.Next(); err != nil {
+			break
+		}
+	}
+	e.Close()
+	if closed != 1 {
+		t.Errorf("Expected the exhausted cursor to be closed once, but was %d", closed)
+	}
+	e.Rewind()
+	e.Next()
+	e.Close()
+	if closed != 2 {
+		t.Errorf("Expected the abandoned cursor to be closed, but was %d", closed)
+	}
+}
+
+func TestExternalEnumeratorCloseDropsPeeked(t *testing.T) {
+	e := ruby.R(1, 3).ToEnum()
+	if v, err := e.Peek(); err != nil || v != 1 {
+		t.Errorf("Expected to peek 1, but got %d, %v", v, err)
+	}
+	e.Close()
+	if _, err := e.Next(); !errors.Is(err, ruby.ErrStopIteration) {
+		t.Errorf("Expected ErrStopIteration after Close, but got %v", err)
+	}
+	if _, err := e.Peek(); !errors.Is(err, ruby.ErrStopIteration) {
+		t.Errorf("Expected ErrStopIteration after Close, but got %v", err)
+	}
+}
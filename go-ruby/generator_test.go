@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+type cursor struct {
+	rows   []string
+	pos    int
+	closed *int
+}
+
+func (c *cursor) HasNext() bool {
+	return c.pos < len(c.rows)
+}
+
+func (c *cursor) Next() string {
+	c.pos++
+	return c.rows[c.pos-1]
+}
+
+func (c *cursor) Close() error {
+	*c.closed++
+	return nil
+}
+
+func TestFromGenerator(t *testing.T) {
+	closed := 0
+	e := ruby.FromGenerator(func() ruby.Enumerator[string] {
+		return &cursor{[]string{"a", "bb", "ccc"}, 0, &closed}
+	})
+	if res := e.Select(func(s string) bool { return len(s) > 1 }).Entries(); !slices.Equal(res, []string{"bb", "ccc"}) {
+		t.Errorf("Expected bb, ccc, but got %v", res)
+	}
+	if res := e.First(1); !slices.Equal(res, []string{"a"}) {
+		t.Errorf("Expected a, but got %v", res)
+	}
+	if closed != 2 {
+		t.Errorf("Expected the cursor to be closed twice, but was %d", closed)
+	}
+}
@@ -1,5 +1,5 @@
 module aschoerk.de/go-ruby
 
-go 1.22.5
+go 1.23
 
 require golang.org/x/exp v0.0.0-20240719175910-8a7402abbf56
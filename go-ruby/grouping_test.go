@@ -0,0 +1,50 @@
+package main
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestTally(t *testing.T) {
+	res := ruby.Tally(ruby.E([]string{"a", "b", "a", "c", "a"}))
+	if !maps.Equal(res, map[string]int{"a": 3, "b": 1, "c": 1}) {
+		t.Errorf("Expected tally, but got %v", res)
+	}
+	if res := ruby.Tally(ruby.E([]int{})); len(res) != 0 {
+		t.Errorf("Expected empty tally, but got %v", res)
+	}
+}
+
+func TestCountByAndGroupBy(t *testing.T) {
+	parity := func(a int) int { return a % 3 }
+	if res := ruby.CountBy(ruby.R(0, 7), parity); !maps.Equal(res, map[int]int{0: 3, 1: 2, 2: 2}) {
+		t.Errorf("Expected counts by remainder, but got %v", res)
+	}
+	res := ruby.GroupBy(ruby.R(0, 7), parity)
+	if !reflect.DeepEqual(res, map[int][]int{0: {0, 3, 6}, 1: {1, 4}, 2: {2, 5}}) {
+		t.Errorf("Expected groups by remainder, but got %v", res)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := ruby.E([]user{{1, "ann"}, {2, "bob"}, {1, "amy"}})
+	res := ruby.IndexBy(users, func(u user) int { return u.id })
+	if len(res) != 2 || res[1].name != "amy" || res[2].name != "bob" {
+		t.Errorf("Expected index by id, but got %v", res)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even, odd := ruby.R(1, 7).Partition(func(a int) bool { return a%2 == 0 })
+	if !slices.Equal(even, []int{2, 4, 6}) || !slices.Equal(odd, []int{1, 3, 5}) {
+		t.Errorf("Expected even and odd numbers, but got %v and %v", even, odd)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestHashOrder(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair("b", 2), ruby.NewPair("a", 1))
+	h.Store("c", 3)
+	h.Store("b", 20)
+	if !slices.Equal(h.Keys(), []string{"b", "a", "c"}) || !slices.Equal(h.Values(), []int{20, 1, 3}) {
+		t.Errorf("Expected insertion order, but got %v", h.ToA())
+	}
+	if v, ok := h.Delete("a"); !ok || v != 1 || h.Len() != 2 || h.HasKey("a") {
+		t.Errorf("Expected a to be deleted, but got %v", h.ToA())
+	}
+	h.Store("a", 4)
+	if !slices.Equal(h.Keys(), []string{"b", "c", "a"}) {
+		t.Errorf("Expected a at the end, but got %v", h.Keys())
+	}
+	if v, ok := h.Get("c"); !ok || v != 3 {
+		t.Errorf("Expected 3 for c, but got %d", v)
+	}
+}
+
+func TestHashIsEnumerable(t *testing.T) {
+	var e ruby.Enumerable[ruby.Pair[string, int]] = ruby.NewHash(ruby.NewPair("a", 1), ruby.NewPair("b", 2))
+	if res := e.Count(func(p ruby.Pair[string, int]) bool { return p.Value > 1 }); res != 1 {
+		t.Errorf("Expected one pair with a value > 1, but got %d", res)
+	}
+	keys := ruby.Map(e, func(p ruby.Pair[string, int]) string { return p.Key }).Entries()
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("Expected a, b, but got %v", keys)
+	}
+}
+
+func TestHashFetch(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair("a", 1))
+	if v, err := h.Fetch("a"); err != nil || v != 1 {
+		t.Errorf("Expected 1, but got %d, %v", v, err)
+	}
+	if _, err := h.Fetch("x"); !errors.Is(err, ruby.ErrKeyNotFound) {
+		t.Errorf("Expected ErrKeyNotFound, but got %v", err)
+	}
+	if v := h.FetchOr("x", 7); v != 7 {
+		t.Errorf("Expected default 7, but got %d", v)
+	}
+	if v := h.FetchFunc("xyz", func(k string) int { return len(k) }); v != 3 {
+		t.Errorf("Expected 3, but got %d", v)
+	}
+}
+
+func TestHashDig(t *testing.T) {
+	inner := ruby.NewHash(ruby.NewPair("port", 8080))
+	h := ruby.NewHash(ruby.NewPair[string, any]("server", inner))
+	if v, ok := h.Dig("server", "port"); !ok || v != 8080 {
+		t.Errorf("Expected 8080, but got %v", v)
+	}
+	if _, ok := h.Dig("server", "host"); ok {
+		t.Errorf("Expected host not to be found")
+	}
+	if _, ok := h.Dig("server", "port", "x"); ok {
+		t.Errorf("Expected digging into an int to fail")
+	}
+	if _, ok := h.Dig(1); ok {
+		t.Errorf("Expected a key of the wrong type not to be found")
+	}
+}
+
+func TestHashTransform(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair("a", 1), ruby.NewPair("b", 2))
+	if res := h.TransformValues(func(v int) int { return v * 10 }); !slices.Equal(res.Values(), []int{10, 20}) {
+		t.Errorf("Expected 10, 20, but got %v", res.Values())
+	}
+	if res := h.TransformKeys(strings.ToUpper); !slices.Equal(res.Keys(), []string{"A", "B"}) {
+		t.Errorf("Expected A, B, but got %v", res.Keys())
+	}
+	lengths := ruby.TransformValues(ruby.NewHash(ruby.NewPair(1, "xy")), func(v string) int { return len(v) })
+	if v, _ := lengths.Get(1); v != 2 {
+		t.Errorf("Expected 2, but got %d", v)
+	}
+}
+
+func TestHashSelectAndMerge(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair("a", 1), ruby.NewPair("b", 2), ruby.NewPair("c", 3))
+	odd := func(k string, v int) bool { return v%2 == 1 }
+	if res := h.SelectPairs(odd); !slices.Equal(res.Keys(), []string{"a", "c"}) {
+		t.Errorf("Expected a, c, but got %v", res.Keys())
+	}
+	if res := h.RejectPairs(odd); !slices.Equal(res.Keys(), []string{"b"}) {
+		t.Errorf("Expected b, but got %v", res.Keys())
+	}
+	other := ruby.NewHash(ruby.NewPair("b", 20), ruby.NewPair("d", 4))
+	if res := h.Merge(other).ToMap(); !maps.Equal(res, map[string]int{"a": 1, "b": 20, "c": 3, "d": 4}) {
+		t.Errorf("Expected b to be overwritten, but got %v", res)
+	}
+	sum := func(k string, old, new int) int { return old + new }
+	if res := h.Merge(other, sum); !slices.Equal(res.Values(), []int{1, 22, 3, 4}) {
+		t.Errorf("Expected b to be summed, but got %v", res.ToA())
+	}
+	if h.Len() != 3 {
+		t.Errorf("Expected Merge to leave h unchanged")
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestCycle(t *testing.T) {
+	if res := ruby.R(1, 4).Cycle().First(7); !slices.Equal(res, []int{1, 2, 3, 1, 2, 3, 1}) {
+		t.Errorf("Expected cycled values, but got %v", res)
+	}
+	if res := ruby.E([]string{"a", "b"}).Cycle(2).Entries(); !slices.Equal(res, []string{"a", "b", "a", "b"}) {
+		t.Errorf("Expected two rounds, but got %v", res)
+	}
+	if res := ruby.E([]int{}).Cycle().Count(); res != 0 {
+		t.Errorf("Expected cycling an empty enumerable to terminate, but got %d", res)
+	}
+	if res := ruby.R(1, 3).Cycle(0).Count(); res != 0 {
+		t.Errorf("Expected no rounds, but got %d", res)
+	}
+	if res := ruby.E([]int{1, 2}).Cycle(-1).Count(); res != 0 {
+		t.Errorf("Expected no rounds for a negative count, but got %d", res)
+	}
+}
+
+func TestRFromAndIterate(t *testing.T) {
+	if res := ruby.RFrom(5).First(3); !slices.Equal(res, []int{5, 6, 7}) {
+		t.Errorf("Expected 5, 6, 7, but got %v", res)
+	}
+	if res := ruby.RFromStepped(10, -5).First(3); !slices.Equal(res, []int{10, 5, 0}) {
+		t.Errorf("Expected 10, 5, 0, but got %v", res)
+	}
+	double := func(a int) int { return a * 2 }
+	if res := ruby.Iterate(1, double).TakeWhile(func(a int) bool { return a < 20 }).Entries(); !slices.Equal(res, []int{1, 2, 4, 8, 16}) {
+		t.Errorf("Expected powers of 2, but got %v", res)
+	}
+}
+
+func TestNewEnumerator(t *testing.T) {
+	fetched := 0
+	pages := ruby.NewEnumerator(func(y ruby.Yielder[[]int]) {
+		for page := 0; page < 3; page++ {
+			fetched++
+			if !y.Yield([]int{page * 2, page*2 + 1}) {
+				return
+			}
+		}
+	})
+	items := ruby.FlatMap(pages, func(page []int) ruby.Enumerable[int] { return ruby.E(page) })
+	if res := items.First(3); !slices.Equal(res, []int{0, 1, 2}) {
+		t.Errorf("Expected 0, 1, 2, but got %v", res)
+	}
+	if fetched != 2 {
+		t.Errorf("Expected 2 pages to be fetched, but were %d", fetched)
+	}
+	if res := items.Entries(); !slices.Equal(res, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("Expected all items, but got %v", res)
+	}
+}
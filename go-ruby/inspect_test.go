@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestInspect(t *testing.T) {
+	var nilPtr *int
+	cases := []struct {
+		got  string
+		want string
+	}{
+		{ruby.R(1, 4).Inspect(), "1...4"},
+		{ruby.R(1, 4).Map(func(a int) int { return a }).Inspect(), "[1, 2, 3]"},
+		{ruby.E([]string{"a", "b\n"}).Inspect(), `["a", "b\n"]`},
+		{ruby.E([]any{nil, 1.0, 2.5, true, nilPtr}).Inspect(), "[nil, 1.0, 2.5, true, nil]"},
+		{ruby.NewArray([]int{1}, []int{}).Inspect(), "[[1], []]"},
+		{ruby.NewHash(ruby.NewPair("a", 1), ruby.NewPair("b", 2)).Inspect(), `{"a" => 1, "b" => 2}`},
+		{ruby.NewHash[string, int]().Inspect(), "{}"},
+		{ruby.NewOrderedSet(1, 2).Inspect(), "#<Set: {1, 2}>"},
+		{ruby.NewExclusiveRange(1, 11).Inspect(), "1...11"},
+		{ruby.NewRange(0.5, 2.0).Inspect(), "0.5..2.0"},
+		{ruby.E([]ruby.Pair[string, int]{ruby.NewPair("x", 1)}).Inspect(), `[["x", 1]]`},
+		{ruby.RFrom(1).Inspect(), "#<Enumerator::Lazy: ...>"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("Expected %s, but got %s", c.want, c.got)
+		}
+	}
+}
+
+func TestInspectOneShot(t *testing.T) {
+	l := ruby.Lines(strings.NewReader("a\nb\n"))
+	if res := fmt.Sprint(l); res != "#<Enumerator: ...>" {
+		t.Errorf("Expected an opaque Enumerator, but got %s", res)
+	}
+	if res := l.Count(); res != 2 {
+		t.Errorf("Expected printing not to consume the lines, but %d were left", res)
+	}
+	silent := make(chan int)
+	if res := ruby.FromChan(silent).Inspect(); res != "#<Enumerator: ...>" {
+		t.Errorf("Expected an opaque Enumerator, but got %s", res)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if res := fmt.Sprintf("%v %s", ruby.E([]int{1, 2}), ruby.NewRange(1, 2)); res != "[1, 2] 1..2" {
+		t.Errorf("Expected [1, 2] 1..2, but got %s", res)
+	}
+	if res := fmt.Sprint(ruby.NewHash(ruby.NewPair(1, "a"))); res != `{1 => "a"}` {
+		t.Errorf(`Expected {1 => "a"}, but got %s`, res)
+	}
+	if res := fmt.Sprintf("%q", ruby.NewArray("a")); res != `"[\"a\"]"` {
+		t.Errorf("Expected a quoted Array, but got %s", res)
+	}
+	if res := fmt.Sprintf("%d", ruby.NewSet[int]()); res != "%!d(#<Set: {}>)" {
+		t.Errorf("Expected a bad verb, but got %s", res)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type response struct {
+		Tags   *ruby.Array[string]     `json:"tags"`
+		Counts *ruby.Hash[string, int] `json:"counts"`
+		Ids    *ruby.Set[int]          `json:"ids"`
+		Range  *ruby.Range[int]        `json:"range"`
+	}
+	in := response{
+		ruby.NewArray("x", "y"),
+		ruby.NewHash(ruby.NewPair("z", 1), ruby.NewPair("a", 2)),
+		ruby.NewOrderedSet(3, 1),
+		ruby.NewExclusiveRange(1, 11),
+	}
+	data, err := json.Marshal(in)
+	want := `{"tags":["x","y"],"counts":{"z":1,"a":2},"ids":[3,1],"range":{"begin":1,"end":11,"exclude_end":true}}`
+	if err != nil || string(data) != want {
+		t.Errorf("Expected %s, but got %s, %v", want, data, err)
+	}
+	var out response
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Expected to unmarshal %s, but got %v", data, err)
+	}
+	out.Tags.Push("z")
+	if res := out.Tags.Entries(); !slices.Equal(res, []string{"x", "y", "z"}) {
+		t.Errorf("Expected x, y, z, but got %v", res)
+	}
+	if res := out.Counts.Keys(); !slices.Equal(res, []string{"z", "a"}) {
+		t.Errorf("Expected the key order z, a, but got %v", res)
+	}
+	if !out.Ids.Include(3) || out.Ids.Len() != 2 {
+		t.Errorf("Expected ids 3 and 1, but got %v", out.Ids)
+	}
+	if out.Range.Size() != 10 || !out.Range.ExcludeEnd() {
+		t.Errorf("Expected 1...11, but got %v", out.Range)
+	}
+}
+
+func TestHashJSONKeys(t *testing.T) {
+	h := ruby.NewHash(ruby.NewPair(2, "b"), ruby.NewPair(-1, "a"))
+	data, err := json.Marshal(h)
+	if err != nil || string(data) != `{"2":"b","-1":"a"}` {
+		t.Errorf("Expected integer keys as strings, but got %s, %v", data, err)
+	}
+	var back ruby.Hash[int, string]
+	if err := json.Unmarshal(data, &back); err != nil || !slices.Equal(back.Keys(), []int{2, -1}) {
+		t.Errorf("Expected keys 2, -1, but got %v, %v", back.Keys(), err)
+	}
+	if _, err := json.Marshal(ruby.NewHash(ruby.NewPair(1.5, 1))); err == nil {
+		t.Errorf("Expected float keys to be rejected")
+	}
+}
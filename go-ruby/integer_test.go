@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestTimes(t *testing.T) {
+	if res := ruby.Times(3).Entries(); !slices.Equal(res, []int{0, 1, 2}) {
+		t.Errorf("Expected 0, 1, 2, but got %v", res)
+	}
+	if res := ruby.Times(-2).Count(); res != 0 {
+		t.Errorf("Expected no iterations, but got %d", res)
+	}
+}
+
+func TestUptoDownto(t *testing.T) {
+	if res := ruby.Upto(1, 4).Entries(); !slices.Equal(res, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected 1..4, but got %v", res)
+	}
+	if res := ruby.Downto(4, 1).Entries(); !slices.Equal(res, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected 4..1, but got %v", res)
+	}
+	if res := ruby.Downto[uint](2, 0).Entries(); !slices.Equal(res, []uint{2, 1, 0}) {
+		t.Errorf("Expected 2, 1, 0, but got %v", res)
+	}
+	if res := ruby.Downto(1, 4).Count(); res != 0 {
+		t.Errorf("Expected no values, but got %d", res)
+	}
+	if res := ruby.Downto(3.0, 1.5).Entries(); !slices.Equal(res, []float64{3, 2}) {
+		t.Errorf("Expected 3.0, 2.0, but got %v", res)
+	}
+	if res := ruby.Downto[int8](-126, -128).Entries(); !slices.Equal(res, []int8{-126, -127, -128}) {
+		t.Errorf("Expected -126, -127, -128, but got %v", res)
+	}
+	if res := ruby.Upto(0.5, 2.5).Entries(); !slices.Equal(res, []float64{0.5, 1.5, 2.5}) {
+		t.Errorf("Expected 0.5, 1.5, 2.5, but got %v", res)
+	}
+}
+
+func TestStep(t *testing.T) {
+	e, err := ruby.Step(1, 10, 3)
+	if err != nil || !slices.Equal(e.Entries(), []int{1, 4, 7, 10}) {
+		t.Errorf("Expected 1, 4, 7, 10, but got %v", e.Entries())
+	}
+	e, _ = ruby.Step(10, 1, -4)
+	if res := e.Entries(); !slices.Equal(res, []int{10, 6, 2}) {
+		t.Errorf("Expected 10, 6, 2, but got %v", res)
+	}
+	f, _ := ruby.Step(1.0, 2.0, 0.5)
+	if res := f.Entries(); !slices.Equal(res, []float64{1, 1.5, 2}) {
+		t.Errorf("Expected 1, 1.5, 2, but got %v", res)
+	}
+	if _, err := ruby.Step(1, 2, 0); !errors.Is(err, ruby.ErrZeroStep) {
+		t.Errorf("Expected ErrZeroStep, but got %v", err)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	e, err := ruby.Digits(1234)
+	if err != nil || !slices.Equal(e.Entries(), []int{4, 3, 2, 1}) {
+		t.Errorf("Expected 4, 3, 2, 1, but got %v", e.Entries())
+	}
+	e, _ = ruby.Digits(0)
+	if res := e.Entries(); !slices.Equal(res, []int{0}) {
+		t.Errorf("Expected 0, but got %v", res)
+	}
+	e, _ = ruby.Digits(255, 16)
+	if res := e.Entries(); !slices.Equal(res, []int{15, 15}) {
+		t.Errorf("Expected 15, 15, but got %v", res)
+	}
+	if _, err := ruby.Digits(-1); !errors.Is(err, ruby.ErrDomain) {
+		t.Errorf("Expected ErrDomain, but got %v", err)
+	}
+	if _, err := ruby.Digits(10, 1); !errors.Is(err, ruby.ErrDomain) {
+		t.Errorf("Expected ErrDomain, but got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestLazyPullsOnlyWhatIsNeeded(t *testing.T) {
+	pulled := 0
+	e := ruby.R(1, math.MaxInt).Lazy().Map(func(a int) int {
+		pulled++
+		return a * 2
+	}).Select(func(a int) bool {
+		return a%3 == 0
+	})
+	if pulled != 0 {
+		t.Errorf("Expected no values to be pulled before a terminal operation, but got %d", pulled)
+	}
+	if res := e.First(3); !slices.Equal(res, []int{6, 12, 18}) {
+		t.Errorf("Expected 6, 12, 18, but got %v", res)
+	}
+	if pulled != 9 {
+		t.Errorf("Expected 9 values to be pulled, but got %d", pulled)
+	}
+}
+
+func TestLazyTake(t *testing.T) {
+	e := ruby.R(0, math.MaxInt).Lazy().Take(4)
+	if !e.IsLazy() {
+		t.Errorf("Expected Take on a lazy enumerable to stay lazy")
+	}
+	if res := e.Entries(); !slices.Equal(res, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected 0..3, but got %v", res)
+	}
+	res := ruby.R(0, math.MaxInt).Lazy().TakeWhile(func(a int) bool { return a*a < 20 }).Entries()
+	if !slices.Equal(res, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected 0..4, but got %v", res)
+	}
+}
+
+func TestEager(t *testing.T) {
+	pulled := 0
+	e := ruby.R(0, 5).Lazy().Eager().Map(func(a int) int {
+		pulled++
+		return a
+	})
+	if e.IsLazy() || pulled != 5 {
+		t.Errorf("Expected eager evaluation, but %d values were pulled", pulled)
+	}
+	if res := ruby.R(0, 10).Take(2).First(5); !slices.Equal(res, []int{0, 1}) {
+		t.Errorf("Expected 0, 1, but got %v", res)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestFirstAndTake(t *testing.T) {
+	if res := ruby.R(1, 10).First(3); !slices.Equal(res, []int{1, 2, 3}) {
+		t.Errorf("Expected 1, 2, 3, but got %v", res)
+	}
+	if res := ruby.R(1, 3).First(5); !slices.Equal(res, []int{1, 2}) {
+		t.Errorf("Expected 1, 2, but got %v", res)
+	}
+	if res := ruby.R(1, 3).First(0); len(res) != 0 {
+		t.Errorf("Expected no values, but got %v", res)
+	}
+	if res := ruby.E([]string{"a", "b", "c"}).Take(2).Entries(); !slices.Equal(res, []string{"a", "b"}) {
+		t.Errorf("Expected a, b, but got %v", res)
+	}
+}
+
+func TestDrop(t *testing.T) {
+	e := ruby.R(1, 6).Drop(3)
+	if res := e.Entries(); !slices.Equal(res, []int{4, 5}) {
+		t.Errorf("Expected 4, 5, but got %v", res)
+	}
+	if res := ruby.R(1, 6).Lazy().Drop(4); res.Count() != 1 || res.Count() != 1 {
+		t.Errorf("Expected each iteration to drop again")
+	}
+	res := ruby.E([]int{1, 2, 5, 1, 6}).DropWhile(func(a int) bool { return a < 3 }).Entries()
+	if !slices.Equal(res, []int{5, 1, 6}) {
+		t.Errorf("Expected 5, 1, 6, but got %v", res)
+	}
+}
+
+func TestFind(t *testing.T) {
+	pulled := 0
+	e := ruby.R(0, math.MaxInt).Lazy().Map(func(a int) int {
+		pulled++
+		return a
+	})
+	if res, ok := e.Find(func(a int) bool { return a*a > 50 }); !ok || res != 8 {
+		t.Errorf("Expected 8, but got %d", res)
+	}
+	if pulled != 9 {
+		t.Errorf("Expected Find to stop after 9 values, but pulled %d", pulled)
+	}
+	if _, ok := ruby.R(0, 5).Detect(func(a int) bool { return a > 5 }); ok {
+		t.Errorf("Expected nothing to be found")
+	}
+}
+
+func TestFindIndex(t *testing.T) {
+	e := ruby.E([]string{"a", "b", "c", "b"})
+	if res := e.FindIndex(func(s string) bool { return s == "b" }); res != 1 {
+		t.Errorf("Expected 1, but got %d", res)
+	}
+	if res := e.FindIndex(func(s string) bool { return s == "x" }); res != -1 {
+		t.Errorf("Expected -1, but got %d", res)
+	}
+}
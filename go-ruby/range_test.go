@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestRange(t *testing.T) {
+	r := ruby.NewRange(1, 5)
+	if res := r.Entries(); !slices.Equal(res, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected 1..5, but got %v", res)
+	}
+	if r.Size() != 5 || !r.Cover(5) || r.Cover(6) || r.Cover(0) {
+		t.Errorf("Expected 1..5 to have size 5 and cover 1 to 5")
+	}
+	x := ruby.NewExclusiveRange(1, 5)
+	if res := x.Entries(); !slices.Equal(res, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected 1...5, but got %v", res)
+	}
+	if x.Size() != 4 || x.Include(5) || !x.Include(4) || !x.ExcludeEnd() {
+		t.Errorf("Expected 1...5 to have size 4 and exclude 5")
+	}
+	if res := ruby.NewRange(5, 1).Size(); res != 0 {
+		t.Errorf("Expected 5..1 to be empty, but had size %d", res)
+	}
+}
+
+func TestRangeStep(t *testing.T) {
+	e, err := ruby.NewRange(1, 10).Step(3)
+	if res := e.Entries(); err != nil || !slices.Equal(res, []int{1, 4, 7, 10}) {
+		t.Errorf("Expected 1, 4, 7, 10, but got %v", res)
+	}
+	e, _ = ruby.NewRange(10, 1).Step(-4)
+	if res := e.Entries(); !slices.Equal(res, []int{10, 6, 2}) {
+		t.Errorf("Expected 10, 6, 2, but got %v", res)
+	}
+	e, _ = ruby.NewExclusiveRange(10, 2).Step(-4)
+	if res := e.Entries(); !slices.Equal(res, []int{10, 6}) {
+		t.Errorf("Expected 10, 6, but got %v", res)
+	}
+	e, _ = ruby.NewRange(1, 10).Step(-1)
+	if e.Count() != 0 {
+		t.Errorf("Expected no values, but got %v", e.Entries())
+	}
+	if _, err := ruby.NewRange(1, 10).Step(0); !errors.Is(err, ruby.ErrZeroStep) {
+		t.Errorf("Expected ErrZeroStep, but got %v", err)
+	}
+}
+
+func TestFloatRange(t *testing.T) {
+	e, _ := ruby.NewRange(0.0, 1.0).Step(0.25)
+	if res := e.Entries(); !slices.Equal(res, []float64{0, 0.25, 0.5, 0.75, 1}) {
+		t.Errorf("Expected quarters, but got %v", res)
+	}
+	e, _ = ruby.NewExclusiveRange(0.0, 1.0).Step(0.1)
+	if res := e.Count(); res != 10 {
+		t.Errorf("Expected 10 values, but got %d", res)
+	}
+	e, _ = ruby.NewRange(0.0, 0.3).Step(0.1)
+	if res := e.Entries(); !slices.Equal(res, []float64{0, 0.1, 0.2, 0.3}) {
+		t.Errorf("Expected 0.0, 0.1, 0.2, 0.3, but got %v", res)
+	}
+	e, _ = ruby.NewExclusiveRange(0.0, 1.0).Step(0.5)
+	if res := e.Entries(); !slices.Equal(res, []float64{0, 0.5}) {
+		t.Errorf("Expected 0.0, 0.5, but got %v", res)
+	}
+	if r := ruby.NewRange(0.5, 1.5); !r.Cover(1.25) || r.Cover(1.75) {
+		t.Errorf("Expected 0.5..1.5 to cover 1.25 only")
+	}
+}
+
+func TestWideIntegerRange(t *testing.T) {
+	if res := ruby.R[int8](-100, 100).Count(); res != 200 {
+		t.Errorf("Expected 200 values, but got %d", res)
+	}
+	if res := ruby.NewRange[int8](math.MinInt8, math.MaxInt8).Entries(); len(res) != 256 || res[0] != math.MinInt8 || res[255] != math.MaxInt8 {
+		t.Errorf("Expected all 256 int8 values, but got %v", res)
+	}
+	if res := ruby.NewRange[int16](-20000, 20000).Size(); res != 40001 {
+		t.Errorf("Expected size 40001, but got %d", res)
+	}
+	if res := ruby.RStepped[int16](20000, -30000, -10000).Entries(); !slices.Equal(res, []int16{20000, 10000, 0, -10000, -20000}) {
+		t.Errorf("Expected 20000 down to -20000, but got %v", res)
+	}
+	if res := ruby.R(math.MinInt64, math.MaxInt64).First(2); !slices.Equal(res, []int{math.MinInt64, math.MinInt64 + 1}) {
+		t.Errorf("Expected the smallest ints, but got %v", res)
+	}
+	if res := ruby.NewRange(math.MinInt64, math.MaxInt64).Size(); res != math.MaxInt {
+		t.Errorf("Expected the size to be capped, but got %d", res)
+	}
+	if res := ruby.NewRange[int64](math.MaxInt64-1, math.MaxInt64).Reverse().Entries(); !slices.Equal(res, []int64{math.MaxInt64, math.MaxInt64 - 1}) {
+		t.Errorf("Expected the largest int64s, but got %v", res)
+	}
+}
+
+func TestZeroRange(t *testing.T) {
+	var r ruby.Range[int]
+	if r.Size() != 1 || !r.Cover(0) {
+		t.Errorf("Expected the zero Range to be 0..0, but had size %d", r.Size())
+	}
+	if res := r.Reverse().Entries(); !slices.Equal(res, []int{0}) {
+		t.Errorf("Expected 0, but got %v", res)
+	}
+}
+
+func TestRangeReverse(t *testing.T) {
+	if res := ruby.NewExclusiveRange(1, 5).Reverse().Entries(); !slices.Equal(res, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected 4, 3, 2, 1, but got %v", res)
+	}
+	if res := ruby.NewRange[uint8](0, 2).Reverse().Entries(); !slices.Equal(res, []uint8{2, 1, 0}) {
+		t.Errorf("Expected 2, 1, 0, but got %v", res)
+	}
+	if res := ruby.RStepped(10, 0, -3).Entries(); !slices.Equal(res, []int{10, 7, 4, 1}) {
+		t.Errorf("Expected 10, 7, 4, 1, but got %v", res)
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	begin := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := ruby.NewExclusiveTimeRange(begin, begin.Add(3*time.Hour))
+	e, err := r.Step(time.Hour)
+	if err != nil || e.Count() != 3 {
+		t.Errorf("Expected 3 hours, but got %v", e.Entries())
+	}
+	if res := e.Entries()[2]; !res.Equal(begin.Add(2 * time.Hour)) {
+		t.Errorf("Expected the last hour to be 02:00, but got %v", res)
+	}
+	if !r.Cover(begin) || r.Cover(r.End()) || !ruby.NewTimeRange(begin, r.End()).Cover(r.End()) {
+		t.Errorf("Expected exclusive and inclusive ends to be covered accordingly")
+	}
+	if _, err := r.Step(0); !errors.Is(err, ruby.ErrZeroStep) {
+		t.Errorf("Expected ErrZeroStep, but got %v", err)
+	}
+}
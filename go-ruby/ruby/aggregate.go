@@ -0,0 +1,108 @@
+package ruby
+
+import "cmp"
+
+// Inject folds e into init from left to right, Reduce is its alias.
+func Inject[T, U any](e Enumerable[T], init U, f func(U, T) U) U {
+	res := init
+	e.Each(func(el T) {
+		res = f(res, el)
+	})
+	return res
+}
+
+func Reduce[T, U any](e Enumerable[T], init U, f func(U, T) U) U {
+	return Inject(e, init, f)
+}
+
+func Sum[T Number](e Enumerable[T]) T {
+	return Inject(e, T(0), func(acc T, el T) T {
+		return acc + el
+	})
+}
+
+func Min[T cmp.Ordered](e Enumerable[T]) (T, bool) {
+	return e.Min(cmp.Compare[T])
+}
+
+func Max[T cmp.Ordered](e Enumerable[T]) (T, bool) {
+	return e.Max(cmp.Compare[T])
+}
+
+func MinMax[T cmp.Ordered](e Enumerable[T]) (T, T, bool) {
+	return e.MinMax(cmp.Compare[T])
+}
+
+func MinBy[T any, K cmp.Ordered](e Enumerable[T], key func(T) K) (T, bool) {
+	return minBy(e, key, -1)
+}
+
+func MaxBy[T any, K cmp.Ordered](e Enumerable[T], key func(T) K) (T, bool) {
+	return minBy(e, key, 1)
+}
+
+func minBy[T any, K cmp.Ordered](e Enumerable[T], key func(T) K, sign int) (T, bool) {
+	var res T
+	var resKey K
+	found := false
+	e.Each(func(el T) {
+		k := key(el)
+		if !found || cmp.Compare(k, resKey)*sign > 0 {
+			res, resKey, found = el, k, true
+		}
+	})
+	return res, found
+}
+
+func (e *enumerableImpl[T]) Inject(f func(T, T) T) (T, bool) {
+	var res T
+	found := false
+	e.Each(func(el T) {
+		if found {
+			res = f(res, el)
+		} else {
+			res, found = el, true
+		}
+	})
+	return res, found
+}
+
+func (e *enumerableImpl[T]) Reduce(f func(T, T) T) (T, bool) {
+	return e.Inject(f)
+}
+
+func (e *enumerableImpl[T]) Min(c Comparator[T]) (T, bool) {
+	return e.Inject(func(a, b T) T {
+		if c(b, a) < 0 {
+			return b
+		}
+		return a
+	})
+}
+
+func (e *enumerableImpl[T]) Max(c Comparator[T]) (T, bool) {
+	return e.Inject(func(a, b T) T {
+		if c(b, a) > 0 {
+			return b
+		}
+		return a
+	})
+}
+
+func (e *enumerableImpl[T]) MinMax(c Comparator[T]) (T, T, bool) {
+	var min, max T
+	found := false
+	e.Each(func(el T) {
+		if !found {
+			min, max, found = el, el, true
+			return
+		}
+		if c(el, min) < 0 {
+			min = el
+		}
+		if c(el, max) > 0 {
+			max = el
+		}
+	})
+	return min, max, found
+}
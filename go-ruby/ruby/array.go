@@ -0,0 +1,301 @@
+package ruby
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+)
+
+var (
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrElementSize     = errors.New("element size differs")
+)
+
+// RNG is satisfied by *rand.Rand of math/rand/v2.
+type RNG interface {
+	IntN(n int) int
+}
+
+type defaultRNG struct{}
+
+func (defaultRNG) IntN(n int) int {
+	return rand.IntN(n)
+}
+
+// Array is a mutable Enumerable, all index parameters count from the end
+// if negative.
+type Array[T any] struct {
+	*enumerableImpl[T]
+	data []T
+}
+
+func NewArray[T any](values ...T) *Array[T] {
+	a := &Array[T]{}
+	a.init(append([]T(nil), values...))
+	return a
+}
+
+func (a *Array[T]) init(data []T) {
+	a.data = data
+	a.enumerableImpl = &enumerableImpl[T]{EnumeratorGenerator: a}
+}
+
+func (a *Array[T]) create() Enumerator[T] {
+	return (&sliceEnumeratorGenerator[T]{a.data}).create()
+}
+
+func (a *Array[T]) values() []T {
+	return a.data
+}
+
+func (a *Array[T]) Len() int {
+	return len(a.data)
+}
+
+func (a *Array[T]) ToSlice() []T {
+	return append([]T(nil), a.data...)
+}
+
+func (a *Array[T]) index(i int) int {
+	if i < 0 {
+		return len(a.data) + i
+	}
+	return i
+}
+
+func (a *Array[T]) At(i int) (T, bool) {
+	var res T
+	i = a.index(i)
+	if i < 0 || i >= len(a.data) {
+		return res, false
+	}
+	return a.data[i], true
+}
+
+// Set pads the Array with zero values if i is beyond its end.
+func (a *Array[T]) Set(i int, value T) error {
+	j := a.index(i)
+	if j < 0 {
+		return fmt.Errorf("%w: %d", ErrIndexOutOfRange, i)
+	}
+	a.pad(j + 1)
+	a.data[j] = value
+	return nil
+}
+
+func (a *Array[T]) pad(n int) {
+	if n > len(a.data) {
+		a.data = append(a.data, make([]T, n-len(a.data))...)
+	}
+}
+
+// Slice returns up to length values starting at start.
+func (a *Array[T]) Slice(start, length int) (*Array[T], bool) {
+	start = a.index(start)
+	if start < 0 || start > len(a.data) || length < 0 {
+		return nil, false
+	}
+	end := min(start+length, len(a.data))
+	return NewArray(a.data[start:end]...), true
+}
+
+func (a *Array[T]) Push(values ...T) *Array[T] {
+	a.data = append(a.data, values...)
+	return a
+}
+
+func (a *Array[T]) Pop() (T, bool) {
+	res, ok := a.At(-1)
+	if ok {
+		a.data = a.data[:len(a.data)-1]
+	}
+	return res, ok
+}
+
+func (a *Array[T]) Shift() (T, bool) {
+	res, ok := a.At(0)
+	if ok {
+		a.data = a.data[1:]
+	}
+	return res, ok
+}
+
+func (a *Array[T]) Unshift(values ...T) *Array[T] {
+	a.data = append(append([]T(nil), values...), a.data...)
+	return a
+}
+
+// Insert inserts values before index i, or after it if i is negative.
+func (a *Array[T]) Insert(i int, values ...T) error {
+	j := i
+	if i < 0 {
+		j = len(a.data) + i + 1
+	}
+	if j < 0 {
+		return fmt.Errorf("%w: %d", ErrIndexOutOfRange, i)
+	}
+	a.pad(j)
+	a.data = slices.Insert(a.data, j, values...)
+	return nil
+}
+
+func (a *Array[T]) DeleteAt(i int) (T, bool) {
+	res, ok := a.At(i)
+	if ok {
+		i = a.index(i)
+		a.data = append(a.data[:i:i], a.data[i+1:]...)
+	}
+	return res, ok
+}
+
+func (a *Array[T]) DeleteIf(f Predicate[T]) *Array[T] {
+	kept := a.data[:0:0]
+	for _, v := range a.data {
+		if !f(v) {
+			kept = append(kept, v)
+		}
+	}
+	a.data = kept
+	return a
+}
+
+// Fill sets all values, the values from bounds[0] on or the bounds[1]
+// values from bounds[0] on to value.
+func (a *Array[T]) Fill(value T, bounds ...int) *Array[T] {
+	if len(bounds) > 2 {
+		panic("Invalid usage of Fill")
+	}
+	start, end := 0, len(a.data)
+	if len(bounds) > 0 {
+		start = max(a.index(bounds[0]), 0)
+	}
+	if len(bounds) > 1 {
+		end = start + bounds[1]
+	}
+	a.pad(end)
+	for i := start; i < end; i++ {
+		a.data[i] = value
+	}
+	return a
+}
+
+// Compact returns the values of a which are not nil.
+func (a *Array[T]) Compact() *Array[T] {
+	res := NewArray[T]()
+	for _, v := range a.data {
+		if !isNil(reflect.ValueOf(v)) {
+			res.data = append(res.data, v)
+		}
+	}
+	return res
+}
+
+// Flatten replaces values which are *Array[T] or []T, possible if T is an
+// interface type, by their values, recursively up to depth levels deep.
+func (a *Array[T]) Flatten(depth ...int) *Array[T] {
+	if len(depth) > 1 {
+		panic("Invalid usage of Flatten")
+	}
+	d := -1
+	if len(depth) == 1 {
+		d = depth[0]
+	}
+	res := NewArray[T]()
+	flatten(res, a.data, d)
+	return res
+}
+
+func flatten[T any](res *Array[T], values []T, depth int) {
+	for _, v := range values {
+		if depth != 0 {
+			switch nested := any(v).(type) {
+			case *Array[T]:
+				flatten(res, nested.data, depth-1)
+				continue
+			case []T:
+				flatten(res, nested, depth-1)
+				continue
+			}
+		}
+		res.data = append(res.data, v)
+	}
+}
+
+// Rotate returns a new Array starting with the value at index n, which
+// defaults to 1.
+func (a *Array[T]) Rotate(n ...int) *Array[T] {
+	if len(n) > 1 {
+		panic("Invalid usage of Rotate")
+	}
+	res := NewArray[T]()
+	if len(a.data) == 0 {
+		return res
+	}
+	shift := 1
+	if len(n) == 1 {
+		shift = n[0]
+	}
+	shift = (shift%len(a.data) + len(a.data)) % len(a.data)
+	res.data = append(append(res.data, a.data[shift:]...), a.data[:shift]...)
+	return res
+}
+
+func (a *Array[T]) Sample(rng ...RNG) (T, bool) {
+	var res T
+	if len(a.data) == 0 {
+		return res, false
+	}
+	return a.data[randomSource(rng).IntN(len(a.data))], true
+}
+
+func (a *Array[T]) Shuffle(rng ...RNG) *Array[T] {
+	r := randomSource(rng)
+	res := NewArray(a.data...)
+	for i := len(res.data) - 1; i > 0; i-- {
+		j := r.IntN(i + 1)
+		res.data[i], res.data[j] = res.data[j], res.data[i]
+	}
+	return res
+}
+
+func randomSource(rng []RNG) RNG {
+	if len(rng) > 1 {
+		panic("Invalid usage of RNG")
+	}
+	if len(rng) == 1 {
+		return rng[0]
+	}
+	return defaultRNG{}
+}
+
+func (a *Array[T]) dig(key any) (any, bool) {
+	i, ok := key.(int)
+	if !ok {
+		return nil, false
+	}
+	return a.At(i)
+}
+
+// Transpose swaps rows and columns, e.g. of the slices of EachSlice.
+func Transpose[T any](rows Enumerable[[]T]) (*Array[[]T], error) {
+	data := rows.Entries()
+	res := NewArray[[]T]()
+	for i, row := range data {
+		if len(row) != len(data[0]) {
+			return nil, fmt.Errorf("%w: row %d has %d values instead of %d", ErrElementSize, i, len(row), len(data[0]))
+		}
+	}
+	if len(data) == 0 {
+		return res, nil
+	}
+	for j := range data[0] {
+		column := make([]T, len(data))
+		for i, row := range data {
+			column[i] = row[j]
+		}
+		res.data = append(res.data, column)
+	}
+	return res, nil
+}
@@ -0,0 +1,251 @@
+package ruby
+
+// Zip combines the i-th values of e and others. As in Ruby the result is
+// as long as e, missing values of shorter others are zero values.
+func Zip[T any](e Enumerable[T], others ...Enumerable[T]) Enumerable[[]T] {
+	return derive[T, []T](e, generatorFunc[[]T](func() Enumerator[[]T] {
+		enumerators := []Enumerator[T]{e.create()}
+		for _, o := range others {
+			enumerators = append(enumerators, o.create())
+		}
+		return &zipEnumerator[T]{enumerators}
+	}))
+}
+
+func Zip2[T, U any](e Enumerable[T], other Enumerable[U]) Enumerable[Pair[T, U]] {
+	return derive[T, Pair[T, U]](e, generatorFunc[Pair[T, U]](func() Enumerator[Pair[T, U]] {
+		return &zip2Enumerator[T, U]{e.create(), other.create()}
+	}))
+}
+
+// Product enumerates the cartesian product of e and others. Like the
+// selections below it is lazy whatever e is, the product may be huge.
+func Product[T any](e Enumerable[T], others ...Enumerable[T]) Enumerable[[]T] {
+	return &enumerableImpl[[]T]{EnumeratorGenerator: generatorFunc[[]T](func() Enumerator[[]T] {
+		pools := [][]T{e.Entries()}
+		for _, o := range others {
+			pools = append(pools, o.Entries())
+		}
+		return odometer(pools)
+	}), lazy: true}
+}
+
+// The following functions yield the selections of n values of e one by
+// one in lexicographic order of their positions in e. The results are lazy
+// even for eager e, only the values of e are collected up front.
+
+func Permutation[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	return selections(e, n, func(pool []T) *selectionEnumerator[T] {
+		k := len(pool)
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		cycles := make([]int, max(n, 0))
+		for i := range cycles {
+			cycles[i] = k - i
+		}
+		first := true
+		return &selectionEnumerator[T]{pools: repeat(pool, n), indices: indices, advance: func() bool {
+			if first {
+				first = false
+				return n <= k
+			}
+			for i := n - 1; i >= 0; i-- {
+				cycles[i]--
+				if cycles[i] == 0 {
+					rotated := indices[i]
+					copy(indices[i:], indices[i+1:])
+					indices[k-1] = rotated
+					cycles[i] = k - i
+				} else {
+					j := k - cycles[i]
+					indices[i], indices[j] = indices[j], indices[i]
+					return true
+				}
+			}
+			return false
+		}}
+	})
+}
+
+func Combination[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	return selections(e, n, func(pool []T) *selectionEnumerator[T] {
+		k := len(pool)
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		first := true
+		return &selectionEnumerator[T]{pools: repeat(pool, n), indices: indices, advance: func() bool {
+			if first {
+				first = false
+				return n <= k
+			}
+			i := n - 1
+			for i >= 0 && indices[i] == k-n+i {
+				i--
+			}
+			if i < 0 {
+				return false
+			}
+			indices[i]++
+			for j := i + 1; j < n; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+			return true
+		}}
+	})
+}
+
+func RepeatedPermutation[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	return selections(e, n, func(pool []T) *selectionEnumerator[T] {
+		return odometer(repeat(pool, n))
+	})
+}
+
+func RepeatedCombination[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	return selections(e, n, func(pool []T) *selectionEnumerator[T] {
+		k := len(pool)
+		indices := make([]int, n)
+		first := true
+		return &selectionEnumerator[T]{pools: repeat(pool, n), indices: indices, advance: func() bool {
+			if first {
+				first = false
+				return k > 0 || n == 0
+			}
+			i := n - 1
+			for i >= 0 && indices[i] == k-1 {
+				i--
+			}
+			if i < 0 {
+				return false
+			}
+			indices[i]++
+			for j := i + 1; j < n; j++ {
+				indices[j] = indices[i]
+			}
+			return true
+		}}
+	})
+}
+
+func selections[T any](e Enumerable[T], n int, start func([]T) *selectionEnumerator[T]) Enumerable[[]T] {
+	return &enumerableImpl[[]T]{EnumeratorGenerator: generatorFunc[[]T](func() Enumerator[[]T] {
+		if n < 0 {
+			return &selectionEnumerator[T]{done: true}
+		}
+		return start(e.Entries())
+	}), lazy: true}
+}
+
+func repeat[T any](pool []T, n int) [][]T {
+	pools := make([][]T, max(n, 0))
+	for i := range pools {
+		pools[i] = pool
+	}
+	return pools
+}
+
+// odometer counts through all index combinations of pools, the last
+// position changing fastest.
+func odometer[T any](pools [][]T) *selectionEnumerator[T] {
+	indices := make([]int, len(pools))
+	first := true
+	return &selectionEnumerator[T]{pools: pools, indices: indices, advance: func() bool {
+		if first {
+			first = false
+			for _, p := range pools {
+				if len(p) == 0 {
+					return false
+				}
+			}
+			return true
+		}
+		for i := len(pools) - 1; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < len(pools[i]) {
+				return true
+			}
+			indices[i] = 0
+		}
+		return false
+	}}
+}
+
+// selectionEnumerator yields pools[i][indices[i]] for all positions i,
+// advance moves the indices to the next selection and reports whether
+// there is one.
+type selectionEnumerator[T any] struct {
+	pools   [][]T
+	indices []int
+	advance func() bool
+	ready   bool
+	done    bool
+}
+
+func (e *selectionEnumerator[T]) HasNext() bool {
+	if !e.ready && !e.done {
+		e.ready = e.advance()
+		e.done = !e.ready
+	}
+	return e.ready
+}
+
+func (e *selectionEnumerator[T]) Next() []T {
+	e.HasNext()
+	e.ready = false
+	res := make([]T, len(e.pools))
+	for i, p := range e.pools {
+		res[i] = p[e.indices[i]]
+	}
+	return res
+}
+
+type zipEnumerator[T any] struct {
+	enumerators []Enumerator[T]
+}
+
+func (e *zipEnumerator[T]) HasNext() bool {
+	return e.enumerators[0].HasNext()
+}
+
+func (e *zipEnumerator[T]) Next() []T {
+	res := make([]T, len(e.enumerators))
+	for i, enumerator := range e.enumerators {
+		if enumerator.HasNext() {
+			res[i] = enumerator.Next()
+		}
+	}
+	return res
+}
+
+func (e *zipEnumerator[T]) Close() error {
+	for _, enumerator := range e.enumerators {
+		closeEnumerator(enumerator)
+	}
+	return nil
+}
+
+type zip2Enumerator[T, U any] struct {
+	first  Enumerator[T]
+	second Enumerator[U]
+}
+
+func (e *zip2Enumerator[T, U]) HasNext() bool {
+	return e.first.HasNext()
+}
+
+func (e *zip2Enumerator[T, U]) Next() Pair[T, U] {
+	res := Pair[T, U]{Key: e.first.Next()}
+	if e.second.HasNext() {
+		res.Value = e.second.Next()
+	}
+	return res
+}
+
+func (e *zip2Enumerator[T, U]) Close() error {
+	closeEnumerator(e.first)
+	closeEnumerator(e.second)
+	return nil
+}
@@ -0,0 +1,62 @@
+package ruby
+
+import "cmp"
+
+// Comparable is implemented by user types ordered like Ruby's Comparable
+// mixin, Compare returns a negative number, 0 or a positive number.
+type Comparable[T any] interface {
+	Compare(other T) int
+}
+
+// Include uses the O(1) membership test of Sets and Ranges.
+func Include[T comparable](e Enumerable[T], t T) bool {
+	if s, ok := e.(interface{ Include(T) bool }); ok {
+		return s.Include(t)
+	}
+	return e.IncludeFunc(t, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// Compare is Ruby's spaceship operator returning -1, 0 or 1.
+func Compare[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+func Between[T cmp.Ordered](v, min, max T) bool {
+	return OrderedComparator[T]().Between(v, min, max)
+}
+
+func Clamp[T cmp.Ordered](v, min, max T) T {
+	return OrderedComparator[T]().Clamp(v, min, max)
+}
+
+func OrderedComparator[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+func ComparableComparator[T Comparable[T]]() Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(a.Compare(b), 0)
+	}
+}
+
+func (c Comparator[T]) Between(v, min, max T) bool {
+	return c(min, v) <= 0 && c(v, max) <= 0
+}
+
+func (c Comparator[T]) Clamp(v, min, max T) T {
+	if c(v, min) < 0 {
+		return min
+	}
+	if c(v, max) > 0 {
+		return max
+	}
+	return v
+}
+
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
@@ -0,0 +1,136 @@
+package ruby
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChan enumerates the values received from ch until it is closed.
+func FromChan[T any](ch <-chan T) Enumerable[T] {
+	return &enumerableImpl[T]{EnumeratorGenerator: &chanEnumeratorGenerator[T]{ch}}
+}
+
+type chanEnumeratorGenerator[T any] struct {
+	ch <-chan T
+}
+
+func (g *chanEnumeratorGenerator[T]) create() Enumerator[T] {
+	return &chanEnumerator[T]{ch: g.ch}
+}
+
+func (g *chanEnumeratorGenerator[T]) createContext(ctx context.Context) Enumerator[T] {
+	return &chanEnumerator[T]{ch: g.ch, done: ctx.Done()}
+}
+
+// chanEnumerator stops receiving when done is closed, cancel is called
+// on Close if set.
+type chanEnumerator[T any] struct {
+	ch      <-chan T
+	done    <-chan struct{}
+	cancel  context.CancelFunc
+	pending T
+	ready   bool
+	closed  bool
+}
+
+func (e *chanEnumerator[T]) HasNext() bool {
+	if !e.ready && !e.closed {
+		select {
+		case e.pending, e.ready = <-e.ch:
+		case <-e.done:
+		}
+		e.closed = !e.ready
+	}
+	return e.ready
+}
+
+func (e *chanEnumerator[T]) Next() T {
+	e.HasNext()
+	e.ready = false
+	return e.pending
+}
+
+func (e *chanEnumerator[T]) Close() error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	return nil
+}
+
+// ToChan sends the values from a new goroutine, the channel is closed
+// when they are exhausted or ctx is done.
+func (e *enumerableImpl[T]) ToChan(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		iterate(e.EnumeratorGenerator, func(el T) bool {
+			select {
+			case ch <- el:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return ch
+}
+
+// ParallelEach calls f from workers goroutines and returns when all calls
+// returned. Use WithContext to make the enumeration cancellable.
+func (e *enumerableImpl[T]) ParallelEach(workers int, f func(T)) {
+	parallel[T](e, workers, func(_ int, el T) {
+		f(el)
+	})
+}
+
+// ParallelMap applies f from workers goroutines, the results keep the
+// order of their values.
+func ParallelMap[T, U any](e Enumerable[T], workers int, f func(T) U) Enumerable[U] {
+	var mu sync.Mutex
+	res := make([]U, 0)
+	parallel(e, workers, func(i int, el T) {
+		u := f(el)
+		mu.Lock()
+		defer mu.Unlock()
+		if i >= len(res) {
+			res = append(res, make([]U, i+1-len(res))...)
+		}
+		res[i] = u
+	})
+	return E(res)
+}
+
+// ParallelMapUnordered is ParallelMap with the results in the order they
+// were computed.
+func ParallelMapUnordered[T, U any](e Enumerable[T], workers int, f func(T) U) Enumerable[U] {
+	var mu sync.Mutex
+	res := make([]U, 0)
+	parallel(e, workers, func(_ int, el T) {
+		u := f(el)
+		mu.Lock()
+		defer mu.Unlock()
+		res = append(res, u)
+	})
+	return E(res)
+}
+
+func parallel[T any](e Enumerable[T], workers int, f func(int, T)) {
+	jobs := make(chan Pair[int, T])
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				f(job.Key, job.Value)
+			}
+		}()
+	}
+	i := 0
+	e.Each(func(el T) {
+		jobs <- Pair[int, T]{i, el}
+		i++
+	})
+	close(jobs)
+	wg.Wait()
+}
@@ -1,36 +1,139 @@
 package ruby
 
-import "reflect"
+import (
+	"io"
+	"reflect"
+)
 
 type enumerableImpl[T any] struct {
 	EnumeratorGenerator[T]
+	lazy bool
 }
 
-func (e *enumerableImpl[T]) Each(f func(T)) {
-	enumerator := e.EnumeratorGenerator.create()
-	for enumerator.hasNext() {
-		f(enumerator.next())
+// sliceBacked and indexed generators are iterated in a plain loop by
+// iterate, saving the Enumerator and its calls per value. Since f is only
+// called there, closures passed by terminal operations do not escape.
+type sliceBacked[T any] interface {
+	values() []T
+}
+
+type indexed[T any] interface {
+	len() int
+	at(i int) T
+}
+
+// directIterator is implemented by generators which can feed their values
+// to f without an Enumerator. It is used where f escapes anyway.
+type directIterator[T any] interface {
+	each(f func(T) bool)
+}
+
+// iterate feeds the values created by g to f until f returns false or
+// the values are exhausted. Enumerators implementing io.Closer are closed
+// in either case.
+func iterate[T any](g EnumeratorGenerator[T], f func(T) bool) {
+	g = unwrap(g)
+	switch g := g.(type) {
+	case sliceBacked[T]:
+		for _, el := range g.values() {
+			if !f(el) {
+				return
+			}
+		}
+	case indexed[T]:
+		for i, n := 0, g.len(); i < n; i++ {
+			if !f(g.at(i)) {
+				return
+			}
+		}
+	default:
+		enumerator := g.create()
+		defer closeEnumerator(enumerator)
+		for enumerator.HasNext() {
+			if !f(enumerator.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// iterateDirect is iterate using directIterator if g implements it.
+func iterateDirect[T any](g EnumeratorGenerator[T], f func(T) bool) {
+	if d, ok := unwrap(g).(directIterator[T]); ok {
+		d.each(f)
+		return
+	}
+	iterate(g, f)
+}
+
+// wrapper is implemented by enumerableImpl, which is not named in iterate
+// to avoid instantiation cycles. Collections embedding enumerableImpl are
+// their own generator.
+type wrapper[T any] interface {
+	generator() EnumeratorGenerator[T]
+}
+
+func unwrap[T any](g EnumeratorGenerator[T]) EnumeratorGenerator[T] {
+	for {
+		w, ok := g.(wrapper[T])
+		if !ok || w.generator() == g {
+			return g
+		}
+		g = w.generator()
 	}
 }
 
+func (e *enumerableImpl[T]) generator() EnumeratorGenerator[T] {
+	return e.EnumeratorGenerator
+}
+
+func closeEnumerator[T any](enumerator Enumerator[T]) {
+	if c, ok := enumerator.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+func (e *enumerableImpl[T]) Each(f func(T)) {
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		f(el)
+		return true
+	})
+}
+
 func (e *enumerableImpl[T]) EachWithIndex(f func(int, T)) {
-	enumerator := e.EnumeratorGenerator.create()
 	i := 0
-	for enumerator.hasNext() {
-		f(i, enumerator.next())
+	e.Each(func(el T) {
+		f(i, el)
 		i++
-	}
+	})
 }
 
+// Deprecated: use Include or IncludeFunc.
 func (e *enumerableImpl[T]) Includes(t T, lessOrEqual func(T, T) bool) bool {
-	enumerator := e.EnumeratorGenerator.create()
-	for enumerator.hasNext() {
-		el := enumerator.next()
-		if lessOrEqual(t, el) && lessOrEqual(el, t) {
-			return true
-		}
+	return e.IncludeFunc(t, func(a, b T) bool {
+		return lessOrEqual(a, b) && lessOrEqual(b, a)
+	})
+}
+
+func (e *enumerableImpl[T]) IncludeFunc(t T, eq func(T, T) bool) bool {
+	found := false
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		found = eq(t, el)
+		return !found
+	})
+	return found
+}
+
+func (e *enumerableImpl[T]) First(n int) []T {
+	a := make([]T, 0)
+	if n <= 0 {
+		return a
 	}
-	return false
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		a = append(a, el)
+		return len(a) < n
+	})
+	return a
 }
 
 func (e *enumerableImpl[T]) Entries() []T {
@@ -52,52 +155,65 @@ func isNil(v reflect.Value) bool {
 	return false
 }
 
-func (e *enumerableImpl[T]) All(f ...Predicate[T]) bool {
+// Truthy follows Ruby: only nil and false are falsy. Typed nil pointers,
+// maps, slices, channels, funcs and interfaces count as nil, values of
+// bool kind as false, everything else, including 0 and "", is truthy.
+func Truthy(v any) bool {
+	rv := reflect.ValueOf(v)
+	if isNil(rv) {
+		return false
+	}
+	return rv.Kind() != reflect.Bool || rv.Bool()
+}
+
+// predicate returns the optional predicate of All, Any, None and One,
+// which defaults to Truthy.
+func predicate[T any](f []Predicate[T], name string) Predicate[T] {
 	if len(f) > 1 {
-		panic("Invalid usage of All")
+		panic("Invalid usage of " + name)
 	}
 	if len(f) == 0 {
-		return e.All(func(x T) bool {
-			return !isNil(reflect.ValueOf(x))
-		})
-	} else {
-		enumerator := e.EnumeratorGenerator.create()
-		for enumerator.hasNext() {
-			if !f[0](enumerator.next()) {
-				return false
-			}
+		return func(x T) bool {
+			return Truthy(x)
 		}
-		return true
 	}
+	return f[0]
 }
 
-func (e *enumerableImpl[T]) Any(f func(T) bool) bool {
-	enumerator := e.EnumeratorGenerator.create()
-	for enumerator.hasNext() {
-		if !f(enumerator.next()) {
-			return true
-		}
-	}
-	return false
+func (e *enumerableImpl[T]) All(f ...Predicate[T]) bool {
+	p := predicate(f, "All")
+	res := true
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		res = p(el)
+		return res
+	})
+	return res
 }
 
-func (e *enumerableImpl[T]) None(f func(T) bool) bool {
-	return !e.Any(f)
+func (e *enumerableImpl[T]) Any(f ...Predicate[T]) bool {
+	p := predicate(f, "Any")
+	res := false
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		res = p(el)
+		return !res
+	})
+	return res
 }
 
-func (e *enumerableImpl[T]) One(f func(T) bool) bool {
-	enumerator := e.EnumeratorGenerator.create()
-	found := false
-	for enumerator.hasNext() {
-		if f(enumerator.next()) {
-			if found {
-				return false
-			} else {
-				found = true
-			}
+func (e *enumerableImpl[T]) None(f ...Predicate[T]) bool {
+	return !e.Any(predicate(f, "None"))
+}
+
+func (e *enumerableImpl[T]) One(f ...Predicate[T]) bool {
+	p := predicate(f, "One")
+	found := 0
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		if p(el) {
+			found++
 		}
-	}
-	return found
+		return found < 2
+	})
+	return found == 1
 }
 
 func (e *enumerableImpl[T]) Count(f ...Predicate[T]) int {
@@ -110,12 +226,108 @@ func (e *enumerableImpl[T]) Count(f ...Predicate[T]) int {
 		})
 	} else {
 		res := 0
-		enumerator := e.EnumeratorGenerator.create()
-		for enumerator.hasNext() {
-			if f[0](enumerator.next()) {
+		e.Each(func(el T) {
+			if f[0](el) {
 				res++
 			}
-		}
+		})
 		return res
 	}
 }
+
+func (e *enumerableImpl[T]) Map(f func(T) T) Enumerable[T] {
+	return Map[T, T](e, f)
+}
+
+func (e *enumerableImpl[T]) Select(f Predicate[T]) Enumerable[T] {
+	return FilterMap[T, T](e, func(x T) (T, bool) {
+		return x, f(x)
+	})
+}
+
+func (e *enumerableImpl[T]) Filter(f Predicate[T]) Enumerable[T] {
+	return e.Select(f)
+}
+
+func (e *enumerableImpl[T]) Reject(f Predicate[T]) Enumerable[T] {
+	return e.Select(func(x T) bool {
+		return !f(x)
+	})
+}
+
+func (e *enumerableImpl[T]) FilterMap(f func(T) (T, bool)) Enumerable[T] {
+	return FilterMap[T, T](e, f)
+}
+
+func (e *enumerableImpl[T]) FlatMap(f func(T) Enumerable[T]) Enumerable[T] {
+	return FlatMap[T, T](e, f)
+}
+
+func (e *enumerableImpl[T]) Take(n int) Enumerable[T] {
+	return derive[T, T](e, &takeEnumeratorGenerator[T]{e, n})
+}
+
+func (e *enumerableImpl[T]) TakeWhile(f Predicate[T]) Enumerable[T] {
+	return derive[T, T](e, &takeWhileEnumeratorGenerator[T]{e, f})
+}
+
+func (e *enumerableImpl[T]) Drop(n int) Enumerable[T] {
+	return derive[T, T](e, &statefulFilterEnumeratorGenerator[T]{e, func() Predicate[T] {
+		dropped := 0
+		return func(T) bool {
+			dropped++
+			return dropped > n
+		}
+	}})
+}
+
+func (e *enumerableImpl[T]) DropWhile(f Predicate[T]) Enumerable[T] {
+	return derive[T, T](e, &statefulFilterEnumeratorGenerator[T]{e, func() Predicate[T] {
+		dropping := true
+		return func(x T) bool {
+			dropping = dropping && f(x)
+			return !dropping
+		}
+	}})
+}
+
+func (e *enumerableImpl[T]) Find(f Predicate[T]) (T, bool) {
+	var res T
+	found := false
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		if f(el) {
+			res, found = el, true
+		}
+		return !found
+	})
+	return res, found
+}
+
+func (e *enumerableImpl[T]) Detect(f Predicate[T]) (T, bool) {
+	return e.Find(f)
+}
+
+// FindIndex returns -1 if no value satisfies f.
+func (e *enumerableImpl[T]) FindIndex(f Predicate[T]) int {
+	res, i := -1, 0
+	iterate(e.EnumeratorGenerator, func(el T) bool {
+		if f(el) {
+			res = i
+		}
+		i++
+		return res < 0
+	})
+	return res
+}
+
+func (e *enumerableImpl[T]) Lazy() Enumerable[T] {
+	return &enumerableImpl[T]{EnumeratorGenerator: e.EnumeratorGenerator, lazy: true}
+}
+
+func (e *enumerableImpl[T]) Eager() Enumerable[T] {
+	return &enumerableImpl[T]{EnumeratorGenerator: e.EnumeratorGenerator}
+}
+
+func (e *enumerableImpl[T]) IsLazy() bool {
+	return e.lazy
+}
@@ -0,0 +1,83 @@
+package ruby
+
+import "errors"
+
+var ErrStopIteration = errors.New("iteration reached an end")
+
+// ExternalEnumerator drives an Enumerable step by step, as Ruby's
+// Enumerator#next does. Close releases the underlying Enumerator when the
+// iteration is abandoned before ErrStopIteration was returned.
+type ExternalEnumerator[T any] struct {
+	generator  EnumeratorGenerator[T]
+	enumerator *peekEnumerator[T]
+}
+
+func (e *enumerableImpl[T]) ToEnum() *ExternalEnumerator[T] {
+	return &ExternalEnumerator[T]{generator: e.EnumeratorGenerator}
+}
+
+func (e *ExternalEnumerator[T]) Next() (T, error) {
+	res, err := e.Peek()
+	if err == nil {
+		e.enumerator.Next()
+	}
+	return res, err
+}
+
+func (e *ExternalEnumerator[T]) Peek() (T, error) {
+	var res T
+	if e.enumerator == nil {
+		e.enumerator = &peekEnumerator[T]{source: e.generator.create()}
+	}
+	if !e.enumerator.HasNext() {
+		e.Close()
+		return res, ErrStopIteration
+	}
+	return e.enumerator.pending, nil
+}
+
+func (e *ExternalEnumerator[T]) Rewind() {
+	e.Close()
+	e.enumerator = nil
+}
+
+func (e *ExternalEnumerator[T]) Close() error {
+	if e.enumerator != nil {
+		e.enumerator.Close()
+	}
+	return nil
+}
+
+type peekEnumerator[T any] struct {
+	source  Enumerator[T]
+	pending T
+	ready   bool
+	done    bool
+	closed  bool
+}
+
+func (e *peekEnumerator[T]) HasNext() bool {
+	if !e.ready && !e.done {
+		e.done = !e.source.HasNext()
+		if !e.done {
+			e.pending, e.ready = e.source.Next(), true
+		}
+	}
+	return e.ready
+}
+
+func (e *peekEnumerator[T]) Next() T {
+	e.HasNext()
+	e.ready = false
+	return e.pending
+}
+
+func (e *peekEnumerator[T]) Close() error {
+	var zero T
+	e.done, e.ready, e.pending = true, false, zero
+	if !e.closed {
+		e.closed = true
+		closeEnumerator(e.source)
+	}
+	return nil
+}
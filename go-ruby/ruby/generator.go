@@ -0,0 +1,13 @@
+package ruby
+
+// FromGenerator makes custom sources enumerable, create is called once
+// for each iteration and must return a fresh Enumerator.
+func FromGenerator[T any](create func() Enumerator[T]) Enumerable[T] {
+	return &enumerableImpl[T]{EnumeratorGenerator: generatorFunc[T](create)}
+}
+
+type generatorFunc[T any] func() Enumerator[T]
+
+func (f generatorFunc[T]) create() Enumerator[T] {
+	return f()
+}
@@ -0,0 +1,46 @@
+package ruby
+
+func Tally[T comparable](e Enumerable[T]) map[T]int {
+	return CountBy(e, func(x T) T {
+		return x
+	})
+}
+
+func CountBy[T any, K comparable](e Enumerable[T], key func(T) K) map[K]int {
+	res := make(map[K]int)
+	e.Each(func(el T) {
+		res[key(el)]++
+	})
+	return res
+}
+
+// GroupBy keeps the values of each group in enumeration order.
+func GroupBy[T any, K comparable](e Enumerable[T], key func(T) K) map[K][]T {
+	res := make(map[K][]T)
+	e.Each(func(el T) {
+		k := key(el)
+		res[k] = append(res[k], el)
+	})
+	return res
+}
+
+// IndexBy maps each key to the last value producing it.
+func IndexBy[T any, K comparable](e Enumerable[T], key func(T) K) map[K]T {
+	res := make(map[K]T)
+	e.Each(func(el T) {
+		res[key(el)] = el
+	})
+	return res
+}
+
+func (e *enumerableImpl[T]) Partition(f Predicate[T]) ([]T, []T) {
+	selected, rejected := make([]T, 0), make([]T, 0)
+	e.Each(func(el T) {
+		if f(el) {
+			selected = append(selected, el)
+		} else {
+			rejected = append(rejected, el)
+		}
+	})
+	return selected, rejected
+}
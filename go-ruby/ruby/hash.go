@@ -0,0 +1,228 @@
+package ruby
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrKeyNotFound = errors.New("key not found")
+
+func NewPair[K, V any](key K, value V) Pair[K, V] {
+	return Pair[K, V]{key, value}
+}
+
+// Hash maps keys to values and enumerates its pairs in insertion order,
+// storing a present key keeps its position. Methods inherited from
+// Enumerable work on the pairs and return Enumerables, SelectPairs and
+// RejectPairs return Hashes.
+type Hash[K comparable, V any] struct {
+	*enumerableImpl[Pair[K, V]]
+	entries []Pair[K, V]
+	index   map[K]int
+}
+
+func NewHash[K comparable, V any](pairs ...Pair[K, V]) *Hash[K, V] {
+	h := &Hash[K, V]{}
+	h.init()
+	for _, p := range pairs {
+		h.Store(p.Key, p.Value)
+	}
+	return h
+}
+
+func (h *Hash[K, V]) init() {
+	h.entries = nil
+	h.index = make(map[K]int)
+	h.enumerableImpl = &enumerableImpl[Pair[K, V]]{EnumeratorGenerator: h}
+}
+
+func (h *Hash[K, V]) create() Enumerator[Pair[K, V]] {
+	return (&sliceEnumeratorGenerator[Pair[K, V]]{h.entries}).create()
+}
+
+func (h *Hash[K, V]) values() []Pair[K, V] {
+	return h.entries
+}
+
+func (h *Hash[K, V]) Len() int {
+	return len(h.entries)
+}
+
+func (h *Hash[K, V]) Store(key K, value V) {
+	if i, ok := h.index[key]; ok {
+		h.entries[i].Value = value
+		return
+	}
+	h.index[key] = len(h.entries)
+	h.entries = append(h.entries, Pair[K, V]{key, value})
+}
+
+func (h *Hash[K, V]) Get(key K) (V, bool) {
+	var res V
+	i, ok := h.index[key]
+	if ok {
+		res = h.entries[i].Value
+	}
+	return res, ok
+}
+
+func (h *Hash[K, V]) HasKey(key K) bool {
+	_, ok := h.index[key]
+	return ok
+}
+
+func (h *Hash[K, V]) Fetch(key K) (V, error) {
+	res, ok := h.Get(key)
+	if !ok {
+		return res, fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	return res, nil
+}
+
+func (h *Hash[K, V]) FetchOr(key K, def V) V {
+	if res, ok := h.Get(key); ok {
+		return res
+	}
+	return def
+}
+
+func (h *Hash[K, V]) FetchFunc(key K, f func(K) V) V {
+	if res, ok := h.Get(key); ok {
+		return res
+	}
+	return f(key)
+}
+
+// Delete keeps the entries enumerated before unchanged.
+func (h *Hash[K, V]) Delete(key K) (V, bool) {
+	var res V
+	i, ok := h.index[key]
+	if !ok {
+		return res, false
+	}
+	res = h.entries[i].Value
+	h.entries = append(h.entries[:i:i], h.entries[i+1:]...)
+	delete(h.index, key)
+	for j := i; j < len(h.entries); j++ {
+		h.index[h.entries[j].Key] = j
+	}
+	return res, true
+}
+
+func (h *Hash[K, V]) Keys() []K {
+	res := make([]K, len(h.entries))
+	for i, p := range h.entries {
+		res[i] = p.Key
+	}
+	return res
+}
+
+func (h *Hash[K, V]) Values() []V {
+	res := make([]V, len(h.entries))
+	for i, p := range h.entries {
+		res[i] = p.Value
+	}
+	return res
+}
+
+func (h *Hash[K, V]) EachPair(f func(K, V)) {
+	h.Each(func(p Pair[K, V]) {
+		f(p.Key, p.Value)
+	})
+}
+
+func (h *Hash[K, V]) ToA() []Pair[K, V] {
+	return h.Entries()
+}
+
+func (h *Hash[K, V]) ToMap() map[K]V {
+	res := make(map[K]V, len(h.entries))
+	for _, p := range h.entries {
+		res[p.Key] = p.Value
+	}
+	return res
+}
+
+// Dig follows keys through nested Hashes, and Arrays for int keys.
+func (h *Hash[K, V]) Dig(keys ...any) (any, bool) {
+	var current any = h
+	for _, key := range keys {
+		d, ok := current.(digger)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = d.dig(key); !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+type digger interface {
+	dig(key any) (any, bool)
+}
+
+func (h *Hash[K, V]) dig(key any) (any, bool) {
+	k, ok := key.(K)
+	if !ok {
+		return nil, false
+	}
+	return h.Get(k)
+}
+
+func (h *Hash[K, V]) TransformValues(f func(V) V) *Hash[K, V] {
+	return TransformValues(h, f)
+}
+
+func (h *Hash[K, V]) TransformKeys(f func(K) K) *Hash[K, V] {
+	return TransformKeys(h, f)
+}
+
+func (h *Hash[K, V]) SelectPairs(f func(K, V) bool) *Hash[K, V] {
+	res := NewHash[K, V]()
+	h.EachPair(func(k K, v V) {
+		if f(k, v) {
+			res.Store(k, v)
+		}
+	})
+	return res
+}
+
+func (h *Hash[K, V]) RejectPairs(f func(K, V) bool) *Hash[K, V] {
+	return h.SelectPairs(func(k K, v V) bool {
+		return !f(k, v)
+	})
+}
+
+// Merge returns a new Hash with the pairs of h and other. Values of keys
+// present in both are taken from other, or from resolve(key, old, new).
+func (h *Hash[K, V]) Merge(other *Hash[K, V], resolve ...func(K, V, V) V) *Hash[K, V] {
+	if len(resolve) > 1 {
+		panic("Invalid usage of Merge")
+	}
+	res := NewHash(h.entries...)
+	other.EachPair(func(k K, v V) {
+		if old, ok := res.Get(k); ok && len(resolve) == 1 {
+			v = resolve[0](k, old, v)
+		}
+		res.Store(k, v)
+	})
+	return res
+}
+
+func TransformValues[K comparable, V, W any](h *Hash[K, V], f func(V) W) *Hash[K, W] {
+	res := NewHash[K, W]()
+	h.EachPair(func(k K, v V) {
+		res.Store(k, f(v))
+	})
+	return res
+}
+
+// TransformKeys keeps the last value if f maps several keys to the same.
+func TransformKeys[K, L comparable, V any](h *Hash[K, V], f func(K) L) *Hash[L, V] {
+	res := NewHash[L, V]()
+	h.EachPair(func(k K, v V) {
+		res.Store(f(k), v)
+	})
+	return res
+}
@@ -0,0 +1,111 @@
+package ruby
+
+import "golang.org/x/exp/constraints"
+
+// The sources of this file are unbounded and therefore lazy, only
+// operations like First or Find terminate on them.
+
+type Yielder[T any] interface {
+	// Yield hands v to the consumer and returns false once the consumer
+	// stopped, the enumerator body should return then.
+	Yield(v T) bool
+}
+
+// NewEnumerator runs body on demand, each value it yields is pulled by
+// the consumer before body continues.
+func NewEnumerator[T any](body func(y Yielder[T])) Enumerable[T] {
+	return FromSeq(func(yield func(T) bool) {
+		body(&yielder[T]{yield: yield})
+	}).Lazy()
+}
+
+// Iterate yields seed, f(seed), f(f(seed)), ...
+func Iterate[T any](seed T, f func(T) T) Enumerable[T] {
+	return NewEnumerator(func(y Yielder[T]) {
+		for v := seed; y.Yield(v); v = f(v) {
+		}
+	})
+}
+
+func RFrom[T constraints.Integer](start T) Enumerable[T] {
+	return RFromStepped(start, 1)
+}
+
+func RFromStepped[T constraints.Integer](start, step T) Enumerable[T] {
+	return Iterate(start, func(x T) T {
+		return x + step
+	})
+}
+
+// Cycle repeats the values n times, or forever if n is omitted. Like Ruby,
+// n <= 0 yields nothing.
+func (e *enumerableImpl[T]) Cycle(n ...int) Enumerable[T] {
+	if len(n) > 1 {
+		panic("Invalid usage of Cycle")
+	}
+	g := &cycleEnumeratorGenerator[T]{e.EnumeratorGenerator, -1}
+	if len(n) == 0 {
+		return &enumerableImpl[T]{EnumeratorGenerator: g, lazy: true}
+	}
+	g.rounds = max(n[0], 0)
+	return derive[T, T](e, g)
+}
+
+type yielder[T any] struct {
+	yield   func(T) bool
+	stopped bool
+}
+
+func (y *yielder[T]) Yield(v T) bool {
+	y.stopped = y.stopped || !y.yield(v)
+	return !y.stopped
+}
+
+type cycleEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	rounds int
+}
+
+func (g *cycleEnumeratorGenerator[T]) create() Enumerator[T] {
+	return &cycleEnumerator[T]{source: g.source, remaining: g.rounds}
+}
+
+// cycleEnumerator stops early if a round yields no values, remaining < 0
+// means infinitely many rounds.
+type cycleEnumerator[T any] struct {
+	source    EnumeratorGenerator[T]
+	current   Enumerator[T]
+	remaining int
+	yielded   bool
+}
+
+func (e *cycleEnumerator[T]) HasNext() bool {
+	for e.current == nil || !e.current.HasNext() {
+		if e.current != nil {
+			closeEnumerator(e.current)
+			e.current = nil
+			if !e.yielded {
+				e.remaining = 0
+			}
+		}
+		if e.remaining == 0 {
+			return false
+		}
+		e.remaining--
+		e.current, e.yielded = e.source.create(), false
+	}
+	return true
+}
+
+func (e *cycleEnumerator[T]) Next() T {
+	e.HasNext()
+	e.yielded = true
+	return e.current.Next()
+}
+
+func (e *cycleEnumerator[T]) Close() error {
+	if e.current != nil {
+		closeEnumerator(e.current)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package ruby
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Inspect renders v the way Ruby's inspect does, e.g. nil, "a", 1.0 or
+// [1, 2]. Values with an Inspect method render themselves.
+func Inspect(v any) string {
+	rv := reflect.ValueOf(v)
+	if isNil(rv) {
+		return "nil"
+	}
+	switch x := v.(type) {
+	case interface{ Inspect() string }:
+		return x.Inspect()
+	case string:
+		return strconv.Quote(x)
+	case float32:
+		return inspectFloat(float64(x), 32)
+	case float64:
+		return inspectFloat(x, 64)
+	case fmt.Stringer:
+		return x.String()
+	}
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		values := make([]string, rv.Len())
+		for i := range values {
+			values[i] = Inspect(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+func inspectFloat(f float64, bits int) string {
+	s := strconv.FormatFloat(f, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+func inspectAll[T any](values []T, open, close string, f func(T) string) string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = f(v)
+	}
+	return open + strings.Join(res, ", ") + close
+}
+
+// format implements fmt.Formatter for the collections, %v and %s print
+// inspected, %q quoted.
+func format(f fmt.State, verb rune, inspected string) {
+	switch verb {
+	case 'v', 's':
+		fmt.Fprint(f, inspected)
+	case 'q':
+		fmt.Fprint(f, strconv.Quote(inspected))
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", verb, inspected)
+	}
+}
+
+func (p Pair[K, V]) Inspect() string {
+	return "[" + Inspect(p.Key) + ", " + Inspect(p.Value) + "]"
+}
+
+// Inspect does not enumerate lazy Enumerables, they may be infinite. Only
+// slice backed Enumerables and ranges are enumerated, streams, channels,
+// seqs and generators could be consumed or block.
+func (e *enumerableImpl[T]) Inspect() string {
+	if e.lazy {
+		return "#<Enumerator::Lazy: ...>"
+	}
+	switch unwrap(e.EnumeratorGenerator).(type) {
+	case sliceBacked[T], indexed[T]:
+		return inspectAll(e.Entries(), "[", "]", func(v T) string {
+			return Inspect(v)
+		})
+	}
+	return "#<Enumerator: ...>"
+}
+
+func (e *enumerableImpl[T]) String() string {
+	return e.Inspect()
+}
+
+func (e *enumerableImpl[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, e.Inspect())
+}
+
+func (h *Hash[K, V]) Inspect() string {
+	if h.Len() == 0 {
+		return "{}"
+	}
+	return inspectAll(h.entries, "{", "}", func(p Pair[K, V]) string {
+		return Inspect(p.Key) + " => " + Inspect(p.Value)
+	})
+}
+
+func (h *Hash[K, V]) String() string {
+	return h.Inspect()
+}
+
+func (h *Hash[K, V]) Format(f fmt.State, verb rune) {
+	format(f, verb, h.Inspect())
+}
+
+func (s *Set[T]) Inspect() string {
+	return inspectAll(s.ToSlice(), "#<Set: {", "}>", func(v T) string {
+		return Inspect(v)
+	})
+}
+
+func (s *Set[T]) String() string {
+	return s.Inspect()
+}
+
+func (s *Set[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, s.Inspect())
+}
+
+func (r *Range[T]) Inspect() string {
+	return inspectRange(Inspect(r.begin), Inspect(r.end), r.exclusive)
+}
+
+func (r *Range[T]) String() string {
+	return r.Inspect()
+}
+
+func (r *Range[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, r.Inspect())
+}
+
+func (r *TimeRange) Inspect() string {
+	return inspectRange(r.begin.String(), r.end.String(), r.exclusive)
+}
+
+func (r *TimeRange) String() string {
+	return r.Inspect()
+}
+
+func (r *TimeRange) Format(f fmt.State, verb rune) {
+	format(f, verb, r.Inspect())
+}
+
+func inspectRange(begin, end string, exclusive bool) string {
+	if exclusive {
+		return begin + "..." + end
+	}
+	return begin + ".." + end
+}
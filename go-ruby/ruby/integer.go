@@ -0,0 +1,58 @@
+package ruby
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrDomain = errors.New("argument out of domain")
+
+// Times is Ruby's n.times, yielding 0 to n-1.
+func Times[T constraints.Integer](n T) Enumerable[T] {
+	return NewExclusiveRange(0, n)
+}
+
+func Upto[T Number](from, to T) Enumerable[T] {
+	return NewRange(from, to)
+}
+
+// Downto steps from from by -1. Unsigned types can not hold -1, their
+// range is reversed instead.
+func Downto[T Number](from, to T) Enumerable[T] {
+	var zero T
+	if zero-1 > 0 {
+		return NewRange(to, from).Reverse()
+	}
+	e, _ := NewRange(from, to).Step(zero - 1)
+	return e
+}
+
+// Step is Ruby's from.step(limit, step), descending if step is negative.
+func Step[T Number](from, limit, step T) (Enumerable[T], error) {
+	return NewRange(from, limit).Step(step)
+}
+
+// Digits yields the digits of n in base, 10 by default, least significant
+// digit first.
+func Digits[T constraints.Integer](n T, base ...T) (Enumerable[T], error) {
+	if len(base) > 1 {
+		panic("Invalid usage of Digits")
+	}
+	b := T(10)
+	if len(base) == 1 {
+		b = base[0]
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("%w: negative number %d", ErrDomain, n)
+	}
+	if b < 2 {
+		return nil, fmt.Errorf("%w: base %d", ErrDomain, b)
+	}
+	digits := []T{n % b}
+	for n /= b; n > 0; n /= b {
+		digits = append(digits, n%b)
+	}
+	return E(digits), nil
+}
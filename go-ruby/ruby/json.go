@@ -0,0 +1,151 @@
+package ruby
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Arrays and Sets marshal to JSON arrays, Hashes to JSON objects keeping
+// their order and Ranges to {"begin": b, "end": e, "exclude_end": x}.
+// Unmarshaling into zero values of these types is fine.
+
+func (a *Array[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]T{}, a.data...))
+}
+
+func (a *Array[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	a.init(values)
+	return nil
+}
+
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]T{}, s.ToSlice()...))
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.init(s.ordered, values)
+	return nil
+}
+
+func (r *Range[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonRange[T]{r.begin, r.end, r.exclusive})
+}
+
+func (r *Range[T]) UnmarshalJSON(data []byte) error {
+	var jr jsonRange[T]
+	if err := json.Unmarshal(data, &jr); err != nil {
+		return err
+	}
+	*r = *newRange(jr.Begin, jr.End, jr.ExcludeEnd)
+	return nil
+}
+
+type jsonRange[T Number] struct {
+	Begin      T    `json:"begin"`
+	End        T    `json:"end"`
+	ExcludeEnd bool `json:"exclude_end"`
+}
+
+func (h *Hash[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, p := range h.entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalKey(p.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		keyJSON, _ := json.Marshal(key)
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (h *Hash[K, V]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return fmt.Errorf("ruby: can't unmarshal %s into Hash", data)
+	}
+	h.init()
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var key K
+		if err := unmarshalKey(t.(string), &key); err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		h.Store(key, value)
+	}
+	return nil
+}
+
+// marshalKey and unmarshalKey support the key types encoding/json
+// supports for maps: strings, integers and encoding.TextMarshaler.
+
+func marshalKey(key any) (string, error) {
+	if m, ok := key.(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	rv := reflect.ValueOf(key)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("ruby: unsupported Hash key type %T", key)
+}
+
+func unmarshalKey(s string, key any) error {
+	if u, ok := key.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	rv := reflect.ValueOf(key).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		rv.SetInt(i)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		rv.SetUint(i)
+		return err
+	}
+	return fmt.Errorf("ruby: unsupported Hash key type %s", rv.Type())
+}
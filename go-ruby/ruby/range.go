@@ -1,34 +1,246 @@
 package ruby
 
-import "golang.org/x/exp/constraints"
+import (
+	"errors"
+	"math"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrZeroStep = errors.New("step can't be 0")
 
 func RStepped[T constraints.Integer](start, end, step T) Enumerable[T] {
-	return &enumerableImpl[T]{EnumeratorGenerator: &rangeEnumeratorGenerator[T]{start, end, step}}
+	g, err := newRangeEnumeratorGenerator(start, end, step, true)
+	if err != nil {
+		panic(err)
+	}
+	return &enumerableImpl[T]{EnumeratorGenerator: g}
 }
 
 func R[T constraints.Integer](start, end T) Enumerable[T] {
-	return RStepped(start, end, 1)
+	return NewExclusiveRange(start, end)
 }
 
-type rangeEnumerator[T constraints.Integer] struct {
-	start, end, step T
-	pos              T
+// Range is Ruby's begin..end, or begin...end if the end is excluded.
+// Ranges with begin > end are empty unless stepped by a negative step.
+type Range[T Number] struct {
+	*enumerableImpl[T]
+	begin, end T
+	exclusive  bool
 }
 
-func (e *rangeEnumerator[T]) hasNext() bool {
-	return e.pos < e.end
+func NewRange[T Number](begin, end T) *Range[T] {
+	return newRange(begin, end, false)
 }
 
-func (e *rangeEnumerator[T]) next() T {
-	res := e.pos
-	e.pos += e.step
-	return res
+func NewExclusiveRange[T Number](begin, end T) *Range[T] {
+	return newRange(begin, end, true)
+}
+
+func newRange[T Number](begin, end T, exclusive bool) *Range[T] {
+	r := &Range[T]{begin: begin, end: end, exclusive: exclusive}
+	r.enumerableImpl = &enumerableImpl[T]{EnumeratorGenerator: r.unitGenerator()}
+	return r
+}
+
+func (r *Range[T]) Begin() T {
+	return r.begin
+}
+
+func (r *Range[T]) End() T {
+	return r.end
+}
+
+func (r *Range[T]) ExcludeEnd() bool {
+	return r.exclusive
+}
+
+func (r *Range[T]) Cover(v T) bool {
+	return r.begin <= v && (v < r.end || !r.exclusive && v == r.end)
+}
+
+func (r *Range[T]) Include(v T) bool {
+	return r.Cover(v)
 }
 
-type rangeEnumeratorGenerator[T constraints.Integer] struct {
-	start, end, step T
+func (r *Range[T]) Size() int {
+	return r.unitGenerator().size
+}
+
+func (r *Range[T]) Step(step T) (Enumerable[T], error) {
+	g, err := newRangeEnumeratorGenerator(r.begin, r.end, step, r.exclusive)
+	if err != nil {
+		return nil, err
+	}
+	return &enumerableImpl[T]{EnumeratorGenerator: g}, nil
+}
+
+func (r *Range[T]) Reverse() Enumerable[T] {
+	g := r.unitGenerator()
+	last := g.at(g.size - 1)
+	return derive[T, T](&enumerableImpl[T]{EnumeratorGenerator: g}, &rangeEnumeratorGenerator[T]{last, -g.step, g.begin, g.size, g.clamp})
+}
+
+// unitGenerator is computed from the bounds, so that the zero Range, 0..0,
+// has a size and a reverse too.
+func (r *Range[T]) unitGenerator() *rangeEnumeratorGenerator[T] {
+	g, _ := newRangeEnumeratorGenerator(r.begin, r.end, 1, r.exclusive)
+	return g
+}
+
+type TimeRange struct {
+	begin, end time.Time
+	exclusive  bool
+}
+
+func NewTimeRange(begin, end time.Time) *TimeRange {
+	return &TimeRange{begin, end, false}
+}
+
+func NewExclusiveTimeRange(begin, end time.Time) *TimeRange {
+	return &TimeRange{begin, end, true}
+}
+
+func (r *TimeRange) Begin() time.Time {
+	return r.begin
+}
+
+func (r *TimeRange) End() time.Time {
+	return r.end
+}
+
+func (r *TimeRange) ExcludeEnd() bool {
+	return r.exclusive
+}
+
+func (r *TimeRange) Cover(t time.Time) bool {
+	return !t.Before(r.begin) && (t.Before(r.end) || !r.exclusive && t.Equal(r.end))
+}
+
+func (r *TimeRange) Step(step time.Duration) (Enumerable[time.Time], error) {
+	g, err := newRangeEnumeratorGenerator(0, r.end.Sub(r.begin), step, r.exclusive)
+	if err != nil {
+		return nil, err
+	}
+	return Map[time.Duration, time.Time](&enumerableImpl[time.Duration]{EnumeratorGenerator: g}, r.begin.Add), nil
+}
+
+// rangeEnumeratorGenerator yields size values begin + i*step, computing
+// each value from its index keeps float ranges free of accumulated errors.
+// Float values overshooting end are clamped to it, as Ruby does.
+type rangeEnumeratorGenerator[T Number] struct {
+	begin, step, end T
+	size             int
+	clamp            bool
+}
+
+func newRangeEnumeratorGenerator[T Number](begin, end, step T, exclusive bool) (*rangeEnumeratorGenerator[T], error) {
+	if step == 0 {
+		return nil, ErrZeroStep
+	}
+	g := &rangeEnumeratorGenerator[T]{begin: begin, step: step, end: end}
+	if isFloat[T]() {
+		g.size = floatStepSize(float64(begin), float64(end), float64(step), exclusive)
+		g.clamp = true
+	} else {
+		g.size = integerStepSize(begin, end, step, exclusive)
+	}
+	return g, nil
+}
+
+func isFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// integerStepSize computes in uint64, where the span of any integer type
+// fits without overflow. Sizes beyond int are capped.
+func integerStepSize[T Number](begin, end, step T, exclusive bool) int {
+	var span, unit uint64
+	switch {
+	case step > 0 && begin <= end:
+		span, unit = uint64(end)-uint64(begin), uint64(step)
+	case step < 0 && begin >= end:
+		span, unit = uint64(begin)-uint64(end), -uint64(step)
+	default:
+		return 0
+	}
+	n := span / unit
+	if exclusive && span%unit == 0 {
+		if n == 0 {
+			return 0
+		}
+		n--
+	}
+	if n >= math.MaxInt {
+		return math.MaxInt
+	}
+	return int(n) + 1
+}
+
+// floatStepSize is ruby_float_step_size, the epsilon correction keeps
+// the end for steps like 0.0..0.3 by 0.1.
+func floatStepSize(begin, end, unit float64, exclusive bool) int {
+	n := (end - begin) / unit
+	e := min((math.Abs(begin)+math.Abs(end)+math.Abs(end-begin))/math.Abs(unit)*epsilon, 0.5)
+	if math.IsInf(unit, 0) {
+		if unit > 0 && begin <= end || unit < 0 && begin >= end {
+			return 1
+		}
+		return 0
+	}
+	if exclusive {
+		if n <= 0 {
+			return 0
+		}
+		if n < 1 {
+			n = 0
+		} else {
+			n = math.Floor(n - e)
+		}
+		d := (n+1)*unit + begin
+		if begin < end && d < end || begin > end && d > end {
+			n++
+		}
+	} else {
+		if n < 0 {
+			return 0
+		}
+		n = math.Floor(n + e)
+	}
+	return int(n) + 1
+}
+
+const epsilon = 0x1p-52
+
+func (g *rangeEnumeratorGenerator[T]) at(i int) T {
+	v := g.begin + T(i)*g.step
+	if g.clamp && (g.step > 0 && v > g.end || g.step < 0 && v < g.end) {
+		return g.end
+	}
+	return v
 }
 
 func (g *rangeEnumeratorGenerator[T]) create() Enumerator[T] {
-	return &rangeEnumerator[T]{g.start, g.end, g.step, g.start}
+	return &rangeEnumerator[T]{g, 0}
+}
+
+func (g *rangeEnumeratorGenerator[T]) len() int {
+	return g.size
+}
+
+type rangeEnumerator[T Number] struct {
+	*rangeEnumeratorGenerator[T]
+	pos int
+}
+
+func (e *rangeEnumerator[T]) HasNext() bool {
+	return e.pos < e.size
+}
+
+func (e *rangeEnumerator[T]) Next() T {
+	res := e.at(e.pos)
+	e.pos++
+	return res
 }
@@ -0,0 +1,83 @@
+package ruby
+
+import (
+	"context"
+	"iter"
+)
+
+// FromSeq wraps seq as Enumerable. Every iteration of the Enumerable
+// ranges over seq again.
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	return &enumerableImpl[T]{EnumeratorGenerator: &seqEnumeratorGenerator[T]{seq}}
+}
+
+func (e *enumerableImpl[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iterate(e.EnumeratorGenerator, yield)
+	}
+}
+
+func (e *enumerableImpl[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		iterate(e.EnumeratorGenerator, func(el T) bool {
+			i++
+			return yield(i-1, el)
+		})
+	}
+}
+
+type seqEnumeratorGenerator[T any] struct {
+	seq iter.Seq[T]
+}
+
+func (g *seqEnumeratorGenerator[T]) create() Enumerator[T] {
+	next, stop := iter.Pull(g.seq)
+	return &seqEnumerator[T]{pull: next, stop: stop}
+}
+
+// createContext ranges over seq in a goroutine, so that waiting for the
+// next value can be given up. A seq blocked in its own code is stopped when
+// it yields its next value.
+func (g *seqEnumeratorGenerator[T]) createContext(ctx context.Context) Enumerator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for v := range g.seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &chanEnumerator[T]{ch: ch, done: ctx.Done(), cancel: cancel}
+}
+
+type seqEnumerator[T any] struct {
+	pull    func() (T, bool)
+	stop    func()
+	pending T
+	ready   bool
+	done    bool
+}
+
+func (e *seqEnumerator[T]) HasNext() bool {
+	if !e.ready && !e.done {
+		e.pending, e.ready = e.pull()
+		e.done = !e.ready
+	}
+	return e.ready
+}
+
+func (e *seqEnumerator[T]) Next() T {
+	e.HasNext()
+	e.ready = false
+	return e.pending
+}
+
+func (e *seqEnumerator[T]) Close() error {
+	e.stop()
+	return nil
+}
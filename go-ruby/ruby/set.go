@@ -0,0 +1,140 @@
+package ruby
+
+import (
+	"maps"
+	"slices"
+)
+
+// Set enumerates its members in unspecified order, or in insertion order
+// if created by NewOrderedSet. Deleting from an ordered Set is O(n).
+type Set[T comparable] struct {
+	*enumerableImpl[T]
+	members map[T]struct{}
+	order   []T
+	ordered bool
+}
+
+func NewSet[T comparable](values ...T) *Set[T] {
+	return newSet(false, values)
+}
+
+func NewOrderedSet[T comparable](values ...T) *Set[T] {
+	return newSet(true, values)
+}
+
+func newSet[T comparable](ordered bool, values []T) *Set[T] {
+	s := &Set[T]{}
+	s.init(ordered, values)
+	return s
+}
+
+func (s *Set[T]) init(ordered bool, values []T) {
+	s.members = make(map[T]struct{})
+	s.order = nil
+	s.ordered = ordered
+	s.enumerableImpl = &enumerableImpl[T]{EnumeratorGenerator: s}
+	s.Add(values...)
+}
+
+func (s *Set[T]) create() Enumerator[T] {
+	return (&sliceEnumeratorGenerator[T]{s.ToSlice()}).create()
+}
+
+func (s *Set[T]) values() []T {
+	return s.ToSlice()
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.members)
+}
+
+func (s *Set[T]) Include(v T) bool {
+	_, ok := s.members[v]
+	return ok
+}
+
+func (s *Set[T]) Add(values ...T) *Set[T] {
+	for _, v := range values {
+		if !s.Include(v) {
+			s.members[v] = struct{}{}
+			if s.ordered {
+				s.order = append(s.order, v)
+			}
+		}
+	}
+	return s
+}
+
+func (s *Set[T]) Delete(v T) bool {
+	if !s.Include(v) {
+		return false
+	}
+	delete(s.members, v)
+	if s.ordered {
+		i := slices.Index(s.order, v)
+		s.order = append(s.order[:i:i], s.order[i+1:]...)
+	}
+	return true
+}
+
+func (s *Set[T]) ToSlice() []T {
+	if s.ordered {
+		return append([]T(nil), s.order...)
+	}
+	return slices.Collect(maps.Keys(s.members))
+}
+
+// The results of the set operations are ordered if s is.
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	return newSet(s.ordered, s.ToSlice()).Add(other.ToSlice()...)
+}
+
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	return s.filter(other.Include)
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.filter(func(v T) bool {
+		return !other.Include(v)
+	})
+}
+
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Add(other.Difference(s).ToSlice()...)
+}
+
+func (s *Set[T]) filter(f Predicate[T]) *Set[T] {
+	res := newSet[T](s.ordered, nil)
+	for _, v := range s.ToSlice() {
+		if f(v) {
+			res.Add(v)
+		}
+	}
+	return res
+}
+
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.members {
+		if !other.Include(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	for v := range s.members {
+		if other.Include(v) {
+			return false
+		}
+	}
+	return true
+}
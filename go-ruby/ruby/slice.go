@@ -9,20 +9,24 @@ type sliceEnumeratorGenerator[T any] struct {
 }
 
 type sliceEnumerator[T any] struct {
-	data *[]T
+	data []T
 	pos  int
 }
 
 func (g *sliceEnumeratorGenerator[T]) create() Enumerator[T] {
-	return &sliceEnumerator[T]{&g.data, 0}
+	return &sliceEnumerator[T]{g.data, 0}
+}
+
+func (g *sliceEnumeratorGenerator[T]) values() []T {
+	return g.data
 }
 
-func (g *sliceEnumerator[T]) hasNext() bool {
-	return g.pos < len(*g.data)
+func (g *sliceEnumerator[T]) HasNext() bool {
+	return g.pos < len(g.data)
 }
 
-func (g *sliceEnumerator[T]) next() T {
-	res := (*g.data)[g.pos]
+func (g *sliceEnumerator[T]) Next() T {
+	res := g.data[g.pos]
 	g.pos++
 	return res
 }
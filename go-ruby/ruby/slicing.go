@@ -0,0 +1,173 @@
+package ruby
+
+// The functions of this file group consecutive values of an Enumerable.
+// They are no methods because the element type changes to []T.
+
+func EachSlice[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	if n <= 0 {
+		panic("Invalid slice size")
+	}
+	return groups(e, func(group []T, el T) bool {
+		return len(group) == n
+	})
+}
+
+func EachCons[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	if n <= 0 {
+		panic("Invalid window size")
+	}
+	return derive[T, []T](e, &consEnumeratorGenerator[T]{e, n})
+}
+
+// Chunk groups consecutive values having the same key.
+func Chunk[T any, K comparable](e Enumerable[T], key func(T) K) Enumerable[Pair[K, []T]] {
+	keyed := &mapEnumeratorGenerator[T, Pair[K, T]]{e, func(x T) Pair[K, T] {
+		return Pair[K, T]{key(x), x}
+	}}
+	chunks := &groupEnumeratorGenerator[Pair[K, T]]{keyed, func(group []Pair[K, T], el Pair[K, T]) bool {
+		return group[len(group)-1].Key != el.Key
+	}}
+	return derive[T, Pair[K, []T]](e, &mapEnumeratorGenerator[[]Pair[K, T], Pair[K, []T]]{chunks, func(group []Pair[K, T]) Pair[K, []T] {
+		values := make([]T, len(group))
+		for i, p := range group {
+			values[i] = p.Value
+		}
+		return Pair[K, []T]{group[0].Key, values}
+	}})
+}
+
+// ChunkWhile keeps adjacent values a, b in one group as long as f(a, b) holds.
+func ChunkWhile[T any](e Enumerable[T], f func(T, T) bool) Enumerable[[]T] {
+	return groups(e, func(group []T, el T) bool {
+		return !f(group[len(group)-1], el)
+	})
+}
+
+// SliceWhen splits between adjacent values a, b if f(a, b) holds.
+func SliceWhen[T any](e Enumerable[T], f func(T, T) bool) Enumerable[[]T] {
+	return groups(e, func(group []T, el T) bool {
+		return f(group[len(group)-1], el)
+	})
+}
+
+func SliceBefore[T any](e Enumerable[T], f Predicate[T]) Enumerable[[]T] {
+	return groups(e, func(group []T, el T) bool {
+		return f(el)
+	})
+}
+
+func SliceAfter[T any](e Enumerable[T], f Predicate[T]) Enumerable[[]T] {
+	return groups(e, func(group []T, el T) bool {
+		return f(group[len(group)-1])
+	})
+}
+
+// EachSlice and EachCons can not delegate to the functions of the same
+// name, enumerableImpl[T] must not instantiate enumerableImpl[[]T].
+
+func (e *enumerableImpl[T]) EachSlice(n int, f func([]T)) {
+	if n <= 0 {
+		panic("Invalid slice size")
+	}
+	iterate(&groupEnumeratorGenerator[T]{e, func(group []T, el T) bool {
+		return len(group) == n
+	}}, func(group []T) bool {
+		f(group)
+		return true
+	})
+}
+
+func (e *enumerableImpl[T]) EachCons(n int, f func([]T)) {
+	if n <= 0 {
+		panic("Invalid window size")
+	}
+	iterate(&consEnumeratorGenerator[T]{e, n}, func(window []T) bool {
+		f(window)
+		return true
+	})
+}
+
+func groups[T any](e Enumerable[T], split func([]T, T) bool) Enumerable[[]T] {
+	return derive[T, []T](e, &groupEnumeratorGenerator[T]{e, split})
+}
+
+// groupEnumeratorGenerator starts a new group before el whenever
+// split(group, el) holds for the non empty group collected so far.
+type groupEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	split  func([]T, T) bool
+}
+
+func (g *groupEnumeratorGenerator[T]) create() Enumerator[[]T] {
+	return &groupEnumerator[T]{source: g.source.create(), split: g.split}
+}
+
+type groupEnumerator[T any] struct {
+	source  Enumerator[T]
+	split   func([]T, T) bool
+	group   []T
+	pending T
+	ready   bool
+}
+
+func (e *groupEnumerator[T]) HasNext() bool {
+	return e.ready || len(e.group) > 0 || e.source.HasNext()
+}
+
+func (e *groupEnumerator[T]) Next() []T {
+	if e.ready {
+		e.group = append(e.group, e.pending)
+		e.ready = false
+	}
+	for e.source.HasNext() {
+		el := e.source.Next()
+		if len(e.group) > 0 && e.split(e.group, el) {
+			e.pending, e.ready = el, true
+			break
+		}
+		e.group = append(e.group, el)
+	}
+	res := e.group
+	e.group = nil
+	return res
+}
+
+func (e *groupEnumerator[T]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
+
+type consEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	n      int
+}
+
+func (g *consEnumeratorGenerator[T]) create() Enumerator[[]T] {
+	return &consEnumerator[T]{source: g.source.create(), window: make([]T, 0, g.n), n: g.n}
+}
+
+type consEnumerator[T any] struct {
+	source Enumerator[T]
+	window []T
+	n      int
+}
+
+func (e *consEnumerator[T]) HasNext() bool {
+	for len(e.window) < e.n && e.source.HasNext() {
+		e.window = append(e.window, e.source.Next())
+	}
+	return len(e.window) == e.n
+}
+
+func (e *consEnumerator[T]) Next() []T {
+	e.HasNext()
+	res := append([]T(nil), e.window...)
+	copy(e.window, e.window[1:])
+	e.window = e.window[:e.n-1]
+	return res
+}
+
+func (e *consEnumerator[T]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
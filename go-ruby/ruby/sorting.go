@@ -0,0 +1,89 @@
+package ruby
+
+import (
+	"cmp"
+	"slices"
+)
+
+func Sort[T cmp.Ordered](e Enumerable[T]) Enumerable[T] {
+	return e.Sort(cmp.Compare[T])
+}
+
+// SortBy computes key once per value and sorts stable by these keys.
+func SortBy[T any, K cmp.Ordered](e Enumerable[T], key func(T) K) Enumerable[T] {
+	return derive[T, T](e, &bufferedEnumeratorGenerator[T]{e, func(values []T) []T {
+		keyed := make([]Pair[K, T], len(values))
+		for i, v := range values {
+			keyed[i] = Pair[K, T]{key(v), v}
+		}
+		slices.SortStableFunc(keyed, func(a, b Pair[K, T]) int {
+			return cmp.Compare(a.Key, b.Key)
+		})
+		for i, p := range keyed {
+			values[i] = p.Value
+		}
+		return values
+	}})
+}
+
+func Uniq[T comparable](e Enumerable[T]) Enumerable[T] {
+	return UniqBy(e, func(x T) T {
+		return x
+	})
+}
+
+// UniqBy keeps the first value for each key.
+func UniqBy[T any, K comparable](e Enumerable[T], key func(T) K) Enumerable[T] {
+	return derive[T, T](e, &statefulFilterEnumeratorGenerator[T]{e, func() Predicate[T] {
+		seen := make(map[K]bool)
+		return func(x T) bool {
+			k := key(x)
+			if seen[k] {
+				return false
+			}
+			seen[k] = true
+			return true
+		}
+	}})
+}
+
+func MinN[T cmp.Ordered](e Enumerable[T], n int) []T {
+	return e.MinN(n, cmp.Compare[T])
+}
+
+func MaxN[T cmp.Ordered](e Enumerable[T], n int) []T {
+	return e.MaxN(n, cmp.Compare[T])
+}
+
+func (e *enumerableImpl[T]) Sort(c Comparator[T]) Enumerable[T] {
+	return derive[T, T](e, &bufferedEnumeratorGenerator[T]{e, func(values []T) []T {
+		slices.SortStableFunc(values, c)
+		return values
+	}})
+}
+
+func (e *enumerableImpl[T]) Reverse() Enumerable[T] {
+	return derive[T, T](e, &bufferedEnumeratorGenerator[T]{e, func(values []T) []T {
+		slices.Reverse(values)
+		return values
+	}})
+}
+
+func (e *enumerableImpl[T]) MinN(n int, c Comparator[T]) []T {
+	return e.Sort(c).First(n)
+}
+
+func (e *enumerableImpl[T]) MaxN(n int, c Comparator[T]) []T {
+	return e.Sort(c.Reverse()).First(n)
+}
+
+// bufferedEnumeratorGenerator collects all values of source and
+// enumerates them after rearranging them by f.
+type bufferedEnumeratorGenerator[T any] struct {
+	source Enumerable[T]
+	f      func([]T) []T
+}
+
+func (g *bufferedEnumeratorGenerator[T]) create() Enumerator[T] {
+	return (&sliceEnumeratorGenerator[T]{g.f(g.source.Entries())}).create()
+}
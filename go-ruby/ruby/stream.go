@@ -0,0 +1,70 @@
+package ruby
+
+import "context"
+
+// Stream enumerates values of a source which may fail or be cut off, Err
+// reports the error that ended the last iteration, if any. Streams over
+// readers can be consumed only once, a further iteration continues where
+// the previous one stopped.
+type Stream[T any] struct {
+	*enumerableImpl[T]
+	err error
+}
+
+func (s *Stream[T]) Err() error {
+	return s.err
+}
+
+// WithContext stops all iterations once ctx is done, Err returns ctx.Err()
+// then. Channel and iter.Seq sources, also behind Map, Select, Take and
+// their relatives, stop waiting for their next value as well.
+func (e *enumerableImpl[T]) WithContext(ctx context.Context) *Stream[T] {
+	s := &Stream[T]{}
+	s.enumerableImpl = &enumerableImpl[T]{EnumeratorGenerator: generatorFunc[T](func() Enumerator[T] {
+		s.err = nil
+		return &contextEnumerator[T]{createContext(e.EnumeratorGenerator, ctx), ctx, s}
+	}), lazy: e.lazy}
+	return s
+}
+
+// contextGenerator is implemented by generators of sources which may block,
+// and by generators forwarding ctx to their source.
+type contextGenerator[T any] interface {
+	createContext(ctx context.Context) Enumerator[T]
+}
+
+func createContext[T any](g EnumeratorGenerator[T], ctx context.Context) Enumerator[T] {
+	if c, ok := unwrap(g).(contextGenerator[T]); ok {
+		return c.createContext(ctx)
+	}
+	return g.create()
+}
+
+func (e *enumerableImpl[T]) EachContext(ctx context.Context, f func(T)) error {
+	s := e.WithContext(ctx)
+	s.Each(f)
+	return s.Err()
+}
+
+type contextEnumerator[T any] struct {
+	source Enumerator[T]
+	ctx    context.Context
+	stream *Stream[T]
+}
+
+func (e *contextEnumerator[T]) HasNext() bool {
+	if e.ctx.Err() == nil && e.source.HasNext() {
+		return true
+	}
+	e.stream.err = e.ctx.Err()
+	return false
+}
+
+func (e *contextEnumerator[T]) Next() T {
+	return e.source.Next()
+}
+
+func (e *contextEnumerator[T]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
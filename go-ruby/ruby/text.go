@@ -0,0 +1,62 @@
+package ruby
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+func Chars(s string) Enumerable[string] {
+	return Map(Runes(s), func(r rune) string {
+		return string(r)
+	})
+}
+
+func Runes(s string) Enumerable[rune] {
+	return E([]rune(s))
+}
+
+func Bytes(s string) Enumerable[byte] {
+	return E([]byte(s))
+}
+
+func Words(s string) Enumerable[string] {
+	return E(strings.Fields(s))
+}
+
+// Lines enumerates the lines of r without their line endings.
+func Lines(r io.Reader) *Stream[string] {
+	return Scan(bufio.NewScanner(r))
+}
+
+func Scan(scanner *bufio.Scanner) *Stream[string] {
+	s := &Stream[string]{}
+	s.enumerableImpl = &enumerableImpl[string]{EnumeratorGenerator: generatorFunc[string](func() Enumerator[string] {
+		return &scanEnumerator{scanner: scanner, stream: s}
+	})}
+	return s
+}
+
+type scanEnumerator struct {
+	scanner *bufio.Scanner
+	stream  *Stream[string]
+	ready   bool
+	done    bool
+}
+
+func (e *scanEnumerator) HasNext() bool {
+	if !e.ready && !e.done {
+		e.ready = e.scanner.Scan()
+		e.done = !e.ready
+		if e.done {
+			e.stream.err = e.scanner.Err()
+		}
+	}
+	return e.ready
+}
+
+func (e *scanEnumerator) Next() string {
+	e.HasNext()
+	e.ready = false
+	return e.scanner.Text()
+}
@@ -0,0 +1,255 @@
+package ruby
+
+import "context"
+
+// Map, FilterMap and FlatMap are the type changing counterparts of the
+// Enumerable methods of the same name; Go methods can not introduce
+// type parameters of their own.
+
+func Map[T, U any](e Enumerable[T], f func(T) U) Enumerable[U] {
+	return derive[T, U](e, &mapEnumeratorGenerator[T, U]{e, f})
+}
+
+func FilterMap[T, U any](e Enumerable[T], f func(T) (U, bool)) Enumerable[U] {
+	return derive[T, U](e, &filterMapEnumeratorGenerator[T, U]{e, f})
+}
+
+func FlatMap[T, U any](e Enumerable[T], f func(T) Enumerable[U]) Enumerable[U] {
+	return derive[T, U](e, &flatMapEnumeratorGenerator[T, U]{e, f})
+}
+
+// derive returns the Enumerable produced by g from source. Lazy sources
+// stay lazy and only pull values when a terminal operation runs, eager
+// sources are materialized right away.
+func derive[T, U any](source Enumerable[T], g EnumeratorGenerator[U]) Enumerable[U] {
+	if source.IsLazy() {
+		return &enumerableImpl[U]{EnumeratorGenerator: g, lazy: true}
+	}
+	a := make([]U, 0)
+	iterateDirect(g, func(el U) bool {
+		a = append(a, el)
+		return true
+	})
+	return E(a)
+}
+
+type mapEnumeratorGenerator[T, U any] struct {
+	source EnumeratorGenerator[T]
+	f      func(T) U
+}
+
+func (g *mapEnumeratorGenerator[T, U]) create() Enumerator[U] {
+	return &mapEnumerator[T, U]{g.source.create(), g.f}
+}
+
+func (g *mapEnumeratorGenerator[T, U]) createContext(ctx context.Context) Enumerator[U] {
+	return &mapEnumerator[T, U]{createContext(g.source, ctx), g.f}
+}
+
+func (g *mapEnumeratorGenerator[T, U]) each(f func(U) bool) {
+	iterateDirect(g.source, func(x T) bool {
+		return f(g.f(x))
+	})
+}
+
+type mapEnumerator[T, U any] struct {
+	source Enumerator[T]
+	f      func(T) U
+}
+
+func (e *mapEnumerator[T, U]) HasNext() bool {
+	return e.source.HasNext()
+}
+
+func (e *mapEnumerator[T, U]) Next() U {
+	return e.f(e.source.Next())
+}
+
+func (e *mapEnumerator[T, U]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
+
+type filterMapEnumeratorGenerator[T, U any] struct {
+	source EnumeratorGenerator[T]
+	f      func(T) (U, bool)
+}
+
+func (g *filterMapEnumeratorGenerator[T, U]) create() Enumerator[U] {
+	return &filterMapEnumerator[T, U]{source: g.source.create(), f: g.f}
+}
+
+func (g *filterMapEnumeratorGenerator[T, U]) createContext(ctx context.Context) Enumerator[U] {
+	return &filterMapEnumerator[T, U]{source: createContext(g.source, ctx), f: g.f}
+}
+
+func (g *filterMapEnumeratorGenerator[T, U]) each(f func(U) bool) {
+	iterateDirect(g.source, func(x T) bool {
+		u, ok := g.f(x)
+		return !ok || f(u)
+	})
+}
+
+type filterMapEnumerator[T, U any] struct {
+	source  Enumerator[T]
+	f       func(T) (U, bool)
+	pending U
+	ready   bool
+}
+
+func (e *filterMapEnumerator[T, U]) HasNext() bool {
+	for !e.ready && e.source.HasNext() {
+		e.pending, e.ready = e.f(e.source.Next())
+	}
+	return e.ready
+}
+
+func (e *filterMapEnumerator[T, U]) Next() U {
+	e.HasNext()
+	e.ready = false
+	return e.pending
+}
+
+func (e *filterMapEnumerator[T, U]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
+
+// statefulFilterEnumeratorGenerator keeps the values accepted by a filter
+// which is created anew for each iteration.
+type statefulFilterEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	filter func() Predicate[T]
+}
+
+func (g *statefulFilterEnumeratorGenerator[T]) create() Enumerator[T] {
+	return g.filtering(g.source.create())
+}
+
+func (g *statefulFilterEnumeratorGenerator[T]) createContext(ctx context.Context) Enumerator[T] {
+	return g.filtering(createContext(g.source, ctx))
+}
+
+func (g *statefulFilterEnumeratorGenerator[T]) filtering(source Enumerator[T]) Enumerator[T] {
+	f := g.filter()
+	return &filterMapEnumerator[T, T]{source: source, f: func(x T) (T, bool) {
+		return x, f(x)
+	}}
+}
+
+type flatMapEnumeratorGenerator[T, U any] struct {
+	source EnumeratorGenerator[T]
+	f      func(T) Enumerable[U]
+}
+
+func (g *flatMapEnumeratorGenerator[T, U]) create() Enumerator[U] {
+	return &flatMapEnumerator[T, U]{source: g.source.create(), f: g.f}
+}
+
+type flatMapEnumerator[T, U any] struct {
+	source  Enumerator[T]
+	f       func(T) Enumerable[U]
+	current Enumerator[U]
+}
+
+func (e *flatMapEnumerator[T, U]) HasNext() bool {
+	for e.current == nil || !e.current.HasNext() {
+		if e.current != nil {
+			closeEnumerator(e.current)
+			e.current = nil
+		}
+		if !e.source.HasNext() {
+			return false
+		}
+		e.current = e.f(e.source.Next()).create()
+	}
+	return true
+}
+
+func (e *flatMapEnumerator[T, U]) Next() U {
+	e.HasNext()
+	return e.current.Next()
+}
+
+func (e *flatMapEnumerator[T, U]) Close() error {
+	if e.current != nil {
+		closeEnumerator(e.current)
+	}
+	closeEnumerator(e.source)
+	return nil
+}
+
+type takeEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	n      int
+}
+
+func (g *takeEnumeratorGenerator[T]) create() Enumerator[T] {
+	return &takeEnumerator[T]{g.source.create(), g.n}
+}
+
+func (g *takeEnumeratorGenerator[T]) createContext(ctx context.Context) Enumerator[T] {
+	return &takeEnumerator[T]{createContext(g.source, ctx), g.n}
+}
+
+type takeEnumerator[T any] struct {
+	source    Enumerator[T]
+	remaining int
+}
+
+func (e *takeEnumerator[T]) HasNext() bool {
+	return e.remaining > 0 && e.source.HasNext()
+}
+
+func (e *takeEnumerator[T]) Next() T {
+	e.remaining--
+	return e.source.Next()
+}
+
+func (e *takeEnumerator[T]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
+
+type takeWhileEnumeratorGenerator[T any] struct {
+	source EnumeratorGenerator[T]
+	f      Predicate[T]
+}
+
+func (g *takeWhileEnumeratorGenerator[T]) create() Enumerator[T] {
+	return &takeWhileEnumerator[T]{source: g.source.create(), f: g.f}
+}
+
+func (g *takeWhileEnumeratorGenerator[T]) createContext(ctx context.Context) Enumerator[T] {
+	return &takeWhileEnumerator[T]{source: createContext(g.source, ctx), f: g.f}
+}
+
+type takeWhileEnumerator[T any] struct {
+	source  Enumerator[T]
+	f       Predicate[T]
+	pending T
+	ready   bool
+	done    bool
+}
+
+func (e *takeWhileEnumerator[T]) HasNext() bool {
+	if !e.ready && !e.done {
+		if e.source.HasNext() {
+			e.pending = e.source.Next()
+			e.ready = e.f(e.pending)
+		}
+		e.done = !e.ready
+	}
+	return e.ready
+}
+
+func (e *takeWhileEnumerator[T]) Next() T {
+	e.HasNext()
+	e.ready = false
+	return e.pending
+}
+
+func (e *takeWhileEnumerator[T]) Close() error {
+	closeEnumerator(e.source)
+	return nil
+}
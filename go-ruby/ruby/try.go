@@ -0,0 +1,78 @@
+package ruby
+
+import "errors"
+
+type ErrorMode int
+
+const (
+	// FirstError stops at the first error and returns it.
+	FirstError ErrorMode = iota
+	// AllErrors skips failing values and returns all errors joined.
+	AllErrors
+)
+
+// The Try functions evaluate eagerly, also on lazy Enumerables, their
+// results contain the values whose callbacks succeeded.
+
+func TryMap[T, U any](e Enumerable[T], f func(T) (U, error), mode ...ErrorMode) (Enumerable[U], error) {
+	res := make([]U, 0)
+	err := tryEach(e, func(el T) error {
+		u, err := f(el)
+		if err == nil {
+			res = append(res, u)
+		}
+		return err
+	}, mode)
+	return E(res), err
+}
+
+func TryReduce[T, U any](e Enumerable[T], init U, f func(U, T) (U, error), mode ...ErrorMode) (U, error) {
+	res := init
+	err := tryEach(e, func(el T) error {
+		u, err := f(res, el)
+		if err == nil {
+			res = u
+		}
+		return err
+	}, mode)
+	return res, err
+}
+
+func (e *enumerableImpl[T]) TryEach(f func(T) error, mode ...ErrorMode) error {
+	return tryEach[T](e, f, mode)
+}
+
+func (e *enumerableImpl[T]) TryMap(f func(T) (T, error), mode ...ErrorMode) (Enumerable[T], error) {
+	return TryMap[T, T](e, f, mode...)
+}
+
+func (e *enumerableImpl[T]) TrySelect(f func(T) (bool, error), mode ...ErrorMode) (Enumerable[T], error) {
+	res := make([]T, 0)
+	err := tryEach[T](e, func(el T) error {
+		ok, err := f(el)
+		if ok && err == nil {
+			res = append(res, el)
+		}
+		return err
+	}, mode)
+	return E(res), err
+}
+
+func tryEach[T any](e EnumeratorGenerator[T], f func(T) error, mode []ErrorMode) error {
+	if len(mode) > 1 {
+		panic("Invalid usage of ErrorMode")
+	}
+	collect := len(mode) == 1 && mode[0] == AllErrors
+	var errs []error
+	iterate(e, func(el T) bool {
+		if err := f(el); err != nil {
+			errs = append(errs, err)
+			return collect
+		}
+		return true
+	})
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
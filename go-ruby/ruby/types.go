@@ -1,8 +1,18 @@
 package ruby
 
+import (
+	"context"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Enumerator is the protocol of all value sources: Next is only called
+// after HasNext returned true. Enumerators also implementing io.Closer
+// are closed once an iteration ends, whether exhausted or stopped early.
 type Enumerator[T any] interface {
-	hasNext() bool
-	next() T
+	HasNext() bool
+	Next() T
 }
 
 type EnumeratorGenerator[T any] interface {
@@ -11,19 +21,89 @@ type EnumeratorGenerator[T any] interface {
 
 type Predicate[T any] func(T) bool
 
+type Comparator[T any] func(a, b T) int
+
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
 type Enumerable[T any] interface {
+	EnumeratorGenerator[T]
+
 	// Querying
 	Includes(T, func(T, T) bool) bool
-	// All() bool
+	IncludeFunc(T, func(T, T) bool) bool
 	All(...Predicate[T]) bool
-	Any(func(T) bool) bool
-	None(func(T) bool) bool
-	One(func(T) bool) bool
+	Any(...Predicate[T]) bool
+	None(...Predicate[T]) bool
+	One(...Predicate[T]) bool
 	Count(...Predicate[T]) int
-	// Tally()
+	Find(Predicate[T]) (T, bool)
+	Detect(Predicate[T]) (T, bool)
+	FindIndex(Predicate[T]) int
+
+	// Grouping
+	Partition(Predicate[T]) ([]T, []T)
+
+	// Transforming
+	Map(func(T) T) Enumerable[T]
+	Select(Predicate[T]) Enumerable[T]
+	Filter(Predicate[T]) Enumerable[T]
+	Reject(Predicate[T]) Enumerable[T]
+	FilterMap(func(T) (T, bool)) Enumerable[T]
+	FlatMap(func(T) Enumerable[T]) Enumerable[T]
+	Take(int) Enumerable[T]
+	TakeWhile(Predicate[T]) Enumerable[T]
+	Drop(int) Enumerable[T]
+	DropWhile(Predicate[T]) Enumerable[T]
+	Cycle(...int) Enumerable[T]
+
+	// Laziness
+	Lazy() Enumerable[T]
+	Eager() Enumerable[T]
+	IsLazy() bool
+
+	// Aggregating
+	Inject(func(T, T) T) (T, bool)
+	Reduce(func(T, T) T) (T, bool)
+	Min(Comparator[T]) (T, bool)
+	Max(Comparator[T]) (T, bool)
+	MinMax(Comparator[T]) (T, T, bool)
+	MinN(int, Comparator[T]) []T
+	MaxN(int, Comparator[T]) []T
+
+	// Ordering
+	Sort(Comparator[T]) Enumerable[T]
+	Reverse() Enumerable[T]
 
 	// Iterating
 	Each(func(T))
 	EachWithIndex(func(int, T))
+	EachSlice(int, func([]T))
+	EachCons(int, func([]T))
 	Entries() []T
+	First(int) []T
+	Seq() iter.Seq[T]
+	Seq2() iter.Seq2[int, T]
+	ToEnum() *ExternalEnumerator[T]
+
+	// Failing callbacks
+	TryEach(func(T) error, ...ErrorMode) error
+	TryMap(func(T) (T, error), ...ErrorMode) (Enumerable[T], error)
+	TrySelect(func(T) (bool, error), ...ErrorMode) (Enumerable[T], error)
+
+	// Concurrency
+	WithContext(context.Context) *Stream[T]
+	EachContext(context.Context, func(T)) error
+	ToChan(context.Context) <-chan T
+	ParallelEach(int, func(T))
+
+	// Printing
+	Inspect() string
+	String() string
 }
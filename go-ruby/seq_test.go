@@ -0,0 +1,62 @@
+package main
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestSeq(t *testing.T) {
+	res := []int{}
+	for x := range ruby.R(1, 10).Seq() {
+		if x > 3 {
+			break
+		}
+		res = append(res, x)
+	}
+	if !slices.Equal(res, []int{1, 2, 3}) {
+		t.Errorf("Expected 1, 2, 3, but got %v", res)
+	}
+	for i, x := range ruby.E([]string{"a", "b"}).Seq2() {
+		if []string{"a", "b"}[i] != x {
+			t.Errorf("Expected index %d to match %s", i, x)
+		}
+	}
+	if res := slices.Collect(ruby.R(0, 3).Map(func(a int) int { return -a }).Seq()); !slices.Equal(res, []int{0, -1, -2}) {
+		t.Errorf("Expected 0, -1, -2, but got %v", res)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	e := ruby.FromSeq(slices.Values([]int{3, 1, 2}))
+	if res := e.Entries(); !slices.Equal(res, []int{3, 1, 2}) {
+		t.Errorf("Expected 3, 1, 2, but got %v", res)
+	}
+	if e.Count() != 3 {
+		t.Errorf("Expected the sequence to be iterable twice")
+	}
+	keys := ruby.FromSeq(maps.Keys(map[string]int{"a": 1, "b": 2}))
+	if res, _ := ruby.Max(keys); res != "b" {
+		t.Errorf("Expected b, but got %s", res)
+	}
+}
+
+func TestFromSeqIsStoppedOnEarlyExit(t *testing.T) {
+	finished := 0
+	e := ruby.FromSeq(func(yield func(int) bool) {
+		defer func() { finished++ }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+	if res := e.Lazy().Map(func(a int) int { return a * 10 }).First(2); !slices.Equal(res, []int{0, 10}) {
+		t.Errorf("Expected 0, 10, but got %v", res)
+	}
+	if e.Includes(5, func(a, b int) bool { return a <= b }); finished != 2 {
+		t.Errorf("Expected the sequence to be stopped twice, but was %d", finished)
+	}
+}
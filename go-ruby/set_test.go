@@ -0,0 +1,70 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestSet(t *testing.T) {
+	s := ruby.NewSet(1, 2, 2, 3)
+	if s.Len() != 3 || !s.Include(2) || s.Include(4) {
+		t.Errorf("Expected 1, 2, 3, but got %v", s.ToSlice())
+	}
+	if !s.Delete(2) || s.Delete(2) || s.Include(2) {
+		t.Errorf("Expected 2 to be deleted once")
+	}
+	if res := s.Count(); res != 2 {
+		t.Errorf("Expected Count 2, but got %d", res)
+	}
+	if !s.All(func(x int) bool { return x%2 == 1 }) {
+		t.Errorf("Expected only odd members, but got %v", s.ToSlice())
+	}
+	if res := slices.Sorted(slices.Values(s.Entries())); !slices.Equal(res, []int{1, 3}) {
+		t.Errorf("Expected 1, 3, but got %v", res)
+	}
+}
+
+func TestOrderedSet(t *testing.T) {
+	s := ruby.NewOrderedSet("c", "a", "b", "a")
+	if res := s.Entries(); !slices.Equal(res, []string{"c", "a", "b"}) {
+		t.Errorf("Expected insertion order, but got %v", res)
+	}
+	s.Delete("a")
+	s.Add("a")
+	if res := s.ToSlice(); !slices.Equal(res, []string{"c", "b", "a"}) {
+		t.Errorf("Expected a to move to the end, but got %v", res)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := ruby.NewOrderedSet(1, 2, 3, 4)
+	b := ruby.NewOrderedSet(3, 4, 5)
+	if res := a.Union(b).ToSlice(); !slices.Equal(res, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected union, but got %v", res)
+	}
+	if res := a.Intersection(b).ToSlice(); !slices.Equal(res, []int{3, 4}) {
+		t.Errorf("Expected intersection, but got %v", res)
+	}
+	if res := a.Difference(b).ToSlice(); !slices.Equal(res, []int{1, 2}) {
+		t.Errorf("Expected difference, but got %v", res)
+	}
+	if res := a.SymmetricDifference(b).ToSlice(); !slices.Equal(res, []int{1, 2, 5}) {
+		t.Errorf("Expected symmetric difference, but got %v", res)
+	}
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Errorf("Expected the operands to stay unchanged")
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	a := ruby.NewSet(1, 2)
+	b := ruby.NewSet(1, 2, 3)
+	if !a.IsSubset(b) || b.IsSubset(a) || !b.IsSuperset(a) || !a.IsSubset(a) {
+		t.Errorf("Expected a to be a subset of b")
+	}
+	if a.IsDisjoint(b) || !a.IsDisjoint(ruby.NewSet(4)) || !ruby.NewSet[int]().IsDisjoint(a) {
+		t.Errorf("Expected disjointness to be detected")
+	}
+}
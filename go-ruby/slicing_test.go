@@ -0,0 +1,89 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestEachSlice(t *testing.T) {
+	res := ruby.EachSlice(ruby.R(1, 8), 3).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}) {
+		t.Errorf("Expected slices of 3, but got %v", res)
+	}
+	batches := [][]string{}
+	ruby.E([]string{"a", "b", "c", "d"}).EachSlice(2, func(batch []string) {
+		batches = append(batches, batch)
+	})
+	if !reflect.DeepEqual(batches, [][]string{{"a", "b"}, {"c", "d"}}) {
+		t.Errorf("Expected batches of 2, but got %v", batches)
+	}
+	if res := ruby.EachSlice(ruby.R(0, 0), 2).Count(); res != 0 {
+		t.Errorf("Expected no slices, but got %d", res)
+	}
+}
+
+func TestEachCons(t *testing.T) {
+	res := ruby.EachCons(ruby.R(1, 5), 2).Entries()
+	if !reflect.DeepEqual(res, [][]int{{1, 2}, {2, 3}, {3, 4}}) {
+		t.Errorf("Expected windows of 2, but got %v", res)
+	}
+	sums := []int{}
+	ruby.R(1, 6).EachCons(3, func(w []int) {
+		sums = append(sums, w[0]+w[1]+w[2])
+	})
+	if !reflect.DeepEqual(sums, []int{6, 9, 12}) {
+		t.Errorf("Expected moving sums, but got %v", sums)
+	}
+	if res := ruby.EachCons(ruby.R(1, 3), 3).Count(); res != 0 {
+		t.Errorf("Expected no windows, but got %d", res)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	res := ruby.Chunk(ruby.E([]int{3, 1, 4, 1, 5, 9, 2, 6}), func(a int) bool { return a%2 == 0 }).Entries()
+	expected := []ruby.Pair[bool, []int]{
+		{Key: false, Value: []int{3, 1}},
+		{Key: true, Value: []int{4}},
+		{Key: false, Value: []int{1, 5, 9}},
+		{Key: true, Value: []int{2, 6}},
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected chunks by parity, but got %v", res)
+	}
+}
+
+func TestChunkWhileAndSliceWhen(t *testing.T) {
+	e := ruby.E([]int{1, 2, 4, 9, 10, 11, 12, 15})
+	consecutive := func(a, b int) bool { return b == a+1 }
+	expected := [][]int{{1, 2}, {4}, {9, 10, 11, 12}, {15}}
+	if res := ruby.ChunkWhile(e, consecutive).Entries(); !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected consecutive runs, but got %v", res)
+	}
+	gap := func(a, b int) bool { return b != a+1 }
+	if res := ruby.SliceWhen(e, gap).Entries(); !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected consecutive runs, but got %v", res)
+	}
+}
+
+func TestSliceBeforeAndAfter(t *testing.T) {
+	lines := ruby.E([]string{"# a", "x", "# b", "y", "z"})
+	header := func(s string) bool { return s[0] == '#' }
+	if res := ruby.SliceBefore(lines, header).Entries(); !reflect.DeepEqual(res, [][]string{{"# a", "x"}, {"# b", "y", "z"}}) {
+		t.Errorf("Expected sections, but got %v", res)
+	}
+	words := ruby.E([]string{"a", "b;", "c", "d;"})
+	end := func(s string) bool { return s[len(s)-1] == ';' }
+	if res := ruby.SliceAfter(words, end).Entries(); !reflect.DeepEqual(res, [][]string{{"a", "b;"}, {"c", "d;"}}) {
+		t.Errorf("Expected statements, but got %v", res)
+	}
+}
+
+func TestLazySlicing(t *testing.T) {
+	res := ruby.EachSlice(ruby.R(0, math.MaxInt).Lazy(), 2).First(2)
+	if !reflect.DeepEqual(res, [][]int{{0, 1}, {2, 3}}) {
+		t.Errorf("Expected two slices, but got %v", res)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestSort(t *testing.T) {
+	data := []int{3, 1, 2}
+	if res := ruby.Sort(ruby.E(data)).Entries(); !slices.Equal(res, []int{1, 2, 3}) {
+		t.Errorf("Expected 1, 2, 3, but got %v", res)
+	}
+	if !slices.Equal(data, []int{3, 1, 2}) {
+		t.Errorf("Expected the source to stay unchanged, but got %v", data)
+	}
+	desc := func(a, b int) int { return cmp.Compare(b, a) }
+	if res := ruby.R(0, 4).Sort(desc).Entries(); !slices.Equal(res, []int{3, 2, 1, 0}) {
+		t.Errorf("Expected 3, 2, 1, 0, but got %v", res)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	calls := 0
+	words := ruby.E([]string{"ccc", "a", "bb", "d", "ee"})
+	res := ruby.SortBy(words, func(s string) int {
+		calls++
+		return len(s)
+	}).Entries()
+	if !slices.Equal(res, []string{"a", "d", "bb", "ee", "ccc"}) {
+		t.Errorf("Expected stable sort by length, but got %v", res)
+	}
+	if calls != 5 {
+		t.Errorf("Expected the key to be computed once per value, but was %d", calls)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	if res := ruby.R(0, 3).Reverse().Entries(); !slices.Equal(res, []int{2, 1, 0}) {
+		t.Errorf("Expected 2, 1, 0, but got %v", res)
+	}
+}
+
+func TestUniq(t *testing.T) {
+	e := ruby.E([]int{1, 2, 1, 3, 2})
+	if res := ruby.Uniq(e).Entries(); !slices.Equal(res, []int{1, 2, 3}) {
+		t.Errorf("Expected 1, 2, 3, but got %v", res)
+	}
+	lazy := ruby.Uniq(e.Lazy())
+	if lazy.Count() != 3 || lazy.Count() != 3 {
+		t.Errorf("Expected each iteration to start with fresh state")
+	}
+	res := ruby.UniqBy(ruby.E([]string{"Ab", "ab", "b", "B"}), strings.ToLower).Entries()
+	if !slices.Equal(res, []string{"Ab", "b"}) {
+		t.Errorf("Expected Ab, b, but got %v", res)
+	}
+}
+
+func TestMinNMaxN(t *testing.T) {
+	e := ruby.E([]int{5, 1, 4, 2, 3})
+	if res := ruby.MinN(e, 2); !slices.Equal(res, []int{1, 2}) {
+		t.Errorf("Expected 1, 2, but got %v", res)
+	}
+	if res := ruby.MaxN(e, 3); !slices.Equal(res, []int{5, 4, 3}) {
+		t.Errorf("Expected 5, 4, 3, but got %v", res)
+	}
+	if res := e.MaxN(10, cmp.Compare[int]); len(res) != 5 {
+		t.Errorf("Expected all 5 values, but got %v", res)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestChars(t *testing.T) {
+	if res := ruby.Chars("häh").Entries(); !slices.Equal(res, []string{"h", "ä", "h"}) {
+		t.Errorf("Expected h, ä, h, but got %v", res)
+	}
+	if res := ruby.Runes("ab").Entries(); !slices.Equal(res, []rune{'a', 'b'}) {
+		t.Errorf("Expected a, b, but got %v", res)
+	}
+	if res := ruby.Bytes("ä").Count(); res != 2 {
+		t.Errorf("Expected 2 bytes, but got %d", res)
+	}
+	if res := ruby.Words("  to be\tor not \n").Entries(); !slices.Equal(res, []string{"to", "be", "or", "not"}) {
+		t.Errorf("Expected words, but got %v", res)
+	}
+}
+
+func TestLines(t *testing.T) {
+	lines := ruby.Lines(strings.NewReader("GET /a\nPOST /b\r\nGET /c"))
+	gets := lines.Select(func(l string) bool { return strings.HasPrefix(l, "GET") }).Entries()
+	if !slices.Equal(gets, []string{"GET /a", "GET /c"}) || lines.Err() != nil {
+		t.Errorf("Expected the GET lines, but got %v, %v", gets, lines.Err())
+	}
+	if lines.Count() != 0 {
+		t.Errorf("Expected the reader to be consumed")
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+func TestScanError(t *testing.T) {
+	lines := ruby.Lines(failingReader{})
+	if lines.Count() != 0 || lines.Err() == nil || lines.Err().Error() != "disk on fire" {
+		t.Errorf("Expected the read error to be reported, but got %v", lines.Err())
+	}
+	scanner := bufio.NewScanner(strings.NewReader("a b  c"))
+	scanner.Split(bufio.ScanWords)
+	if res := ruby.Scan(scanner).Entries(); !slices.Equal(res, []string{"a", "b", "c"}) {
+		t.Errorf("Expected a, b, c, but got %v", res)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestMap(t *testing.T) {
+	res := ruby.R(1, 5).Map(func(a int) int { return a * a }).Entries()
+	if !slices.Equal(res, []int{1, 4, 9, 16}) {
+		t.Errorf("Expected squares, but got %v", res)
+	}
+	strs := ruby.Map(ruby.E([]int{1, 2}), strconv.Itoa).Entries()
+	if !slices.Equal(strs, []string{"1", "2"}) {
+		t.Errorf("Expected strings, but got %v", strs)
+	}
+}
+
+func TestSelectReject(t *testing.T) {
+	even := func(a int) bool { return a%2 == 0 }
+	if res := ruby.R(1, 11).Select(even).Entries(); !slices.Equal(res, []int{2, 4, 6, 8, 10}) {
+		t.Errorf("Expected even numbers, but got %v", res)
+	}
+	if res := ruby.R(1, 11).Filter(even).Count(); res != 5 {
+		t.Errorf("Expected 5 even numbers, but got %d", res)
+	}
+	if res := ruby.R(1, 11).Reject(even).Entries(); !slices.Equal(res, []int{1, 3, 5, 7, 9}) {
+		t.Errorf("Expected odd numbers, but got %v", res)
+	}
+	if res := ruby.E([]int{}).Select(even).Entries(); len(res) != 0 {
+		t.Errorf("Expected no entries, but got %v", res)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	res := ruby.FilterMap(ruby.E([]string{"1", "x", "3"}), func(s string) (int, bool) {
+		i, err := strconv.Atoi(s)
+		return i, err == nil
+	}).Entries()
+	if !slices.Equal(res, []int{1, 3}) {
+		t.Errorf("Expected parsed numbers, but got %v", res)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	res := ruby.R(1, 4).FlatMap(func(a int) ruby.Enumerable[int] {
+		return ruby.R(0, a)
+	}).Entries()
+	if !slices.Equal(res, []int{0, 0, 1, 0, 1, 2}) {
+		t.Errorf("Expected flattened ranges, but got %v", res)
+	}
+	empty := ruby.FlatMap(ruby.R(0, 3), func(a int) ruby.Enumerable[string] {
+		return ruby.E([]string{})
+	})
+	if empty.Count() != 0 {
+		t.Errorf("Expected no entries, but got %v", empty.Entries())
+	}
+}
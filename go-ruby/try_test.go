@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"aschoerk.de/go-ruby/ruby"
+)
+
+func TestTryEach(t *testing.T) {
+	boom := errors.New("boom")
+	seen := []int{}
+	err := ruby.R(0, 10).TryEach(func(a int) error {
+		if a == 3 {
+			return boom
+		}
+		seen = append(seen, a)
+		return nil
+	})
+	if err != boom || !slices.Equal(seen, []int{0, 1, 2}) {
+		t.Errorf("Expected to stop at 3 with boom, but got %v after %v", err, seen)
+	}
+	if err := ruby.R(0, 3).TryEach(func(int) error { return nil }); err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	input := ruby.E([]string{"1", "x", "3", "y"})
+	res, err := ruby.TryMap(input, strconv.Atoi)
+	if err == nil || !slices.Equal(res.Entries(), []int{1}) {
+		t.Errorf("Expected to stop at x, but got %v, %v", res.Entries(), err)
+	}
+	res, err = ruby.TryMap(input, strconv.Atoi, ruby.AllErrors)
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) || numErr.Num != "x" || !slices.Equal(res.Entries(), []int{1, 3}) {
+		t.Errorf("Expected 1, 3 and both errors, but got %v, %v", res.Entries(), err)
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 2 {
+		t.Errorf("Expected 2 joined errors, but got %v", err)
+	}
+	doubled, err := ruby.R(1, 3).TryMap(func(a int) (int, error) { return a * 2, nil })
+	if err != nil || !slices.Equal(doubled.Entries(), []int{2, 4}) {
+		t.Errorf("Expected 2, 4, but got %v, %v", doubled.Entries(), err)
+	}
+}
+
+func TestTrySelect(t *testing.T) {
+	res, err := ruby.R(0, 6).TrySelect(func(a int) (bool, error) {
+		if a == 4 {
+			return false, fmt.Errorf("can't check %d", a)
+		}
+		return a%2 == 0, nil
+	}, ruby.AllErrors)
+	if err == nil || !slices.Equal(res.Entries(), []int{0, 2}) {
+		t.Errorf("Expected 0, 2 and an error, but got %v, %v", res.Entries(), err)
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	add := func(acc int, s string) (int, error) {
+		i, err := strconv.Atoi(s)
+		return acc + i, err
+	}
+	if res, err := ruby.TryReduce(ruby.E([]string{"1", "2", "3"}), 0, add); err != nil || res != 6 {
+		t.Errorf("Expected 6, but got %d, %v", res, err)
+	}
+	if res, err := ruby.TryReduce(ruby.E([]string{"1", "x", "3"}), 0, add); err == nil || res != 1 {
+		t.Errorf("Expected 1 and an error, but got %d, %v", res, err)
+	}
+	if res, err := ruby.TryReduce(ruby.E([]string{"1", "x", "3"}), 0, add, ruby.AllErrors); err == nil || res != 4 {
+		t.Errorf("Expected 4 and an error, but got %d, %v", res, err)
+	}
+}